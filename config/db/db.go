@@ -2,75 +2,131 @@ package db
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func GetUserCollection() (*mongo.Collection, error) {
-	clientOptions := options.Client().ApplyURI("mongodb://localhost:27017")
-	client, err := mongo.Connect(context.TODO(), clientOptions)
-	if err != nil {
-		return nil, err
+// Monitor -- optional command monitor attached to every client, set by the server to collect metrics
+var Monitor *event.CommandMonitor
+
+func clientOptions() *options.ClientOptions {
+	opts := options.Client().ApplyURI("mongodb://localhost:27017")
+	if Monitor != nil {
+		opts.SetMonitor(Monitor)
 	}
-	// Check the connection
-	err = client.Ping(context.TODO(), nil)
+	return opts
+}
+
+// client -- shared by every collection, created once by Connect
+var client *mongo.Client
+
+// Connect -- creates the shared client, call it once at startup after setting Monitor
+func Connect(ctx context.Context) error {
+	c, err := mongo.Connect(ctx, clientOptions())
 	if err != nil {
-		return nil, err
+		return err
 	}
+	client = c
+	return nil
+}
 
-	collection := client.Database("AirHockeyDB").Collection("users")
-	fmt.Println("[USER_DB] is good")
-	return collection, nil
+// Disconnect -- closes the shared client on shutdown
+func Disconnect(ctx context.Context) error {
+	if client == nil {
+		return nil
+	}
+	return client.Disconnect(ctx)
 }
 
-func GetRecordCollection() (*mongo.Collection, error) {
-	clientOptions := options.Client().ApplyURI("mongodb://localhost:27017")
-	client, err := mongo.Connect(context.TODO(), clientOptions)
-	if err != nil {
-		return nil, err
+// Ping -- checks that the database is reachable, used by the health checks
+func Ping(ctx context.Context) error {
+	if client == nil {
+		return errors.New("database client not connected")
 	}
-	// Check the connection
-	err = client.Ping(context.TODO(), nil)
-	if err != nil {
-		return nil, err
+	return client.Ping(ctx, nil)
+}
+
+func getCollection(name string, tag string) (*mongo.Collection, error) {
+	if client == nil {
+		return nil, errors.New("[" + tag + "] database client not connected")
 	}
+	return client.Database("AirHockeyDB").Collection(name), nil
+}
 
-	collection := client.Database("AirHockeyDB").Collection("records")
-	fmt.Println("[RECORD_DB] is good")
-	return collection, nil
+func GetUserCollection() (*mongo.Collection, error) {
+	return getCollection("users", "USER_DB")
 }
 
-func GetHighScoreCollection() (*mongo.Collection, error) {
-	clientOptions := options.Client().ApplyURI("mongodb://localhost:27017")
-	client, err := mongo.Connect(context.TODO(), clientOptions)
-	if err != nil {
-		return nil, err
-	}
-	// Check the connection
-	err = client.Ping(context.TODO(), nil)
-	if err != nil {
-		return nil, err
-	}
+func GetRecordCollection() (*mongo.Collection, error) {
+	return getCollection("records", "RECORD_DB")
+}
 
-	collection := client.Database("AirHockeyDB").Collection("highScore")
-	fmt.Println("[HIGH_SCORE_DB] is good")
-	return collection, nil
+func GetHighScoreCollection() (*mongo.Collection, error) {
+	return getCollection("highScore", "HIGH_SCORE_DB")
 }
 
 func GetSkinCollection() (*mongo.Collection, error) {
-	clientOptions := options.Client().ApplyURI("mongodb://localhost:27017")
-	client, err := mongo.Connect(context.TODO(), clientOptions)
-	if err != nil {
-		return nil, err
-	}
-	// Check the connection
-	err = client.Ping(context.TODO(), nil)
-	if err != nil {
-		return nil, err
-	}
+	return getCollection("skin", "SKIN_DB")
+}
 
-	collection := client.Database("AirHockeyDB").Collection("skin")
-	fmt.Println("[RECORD_DB] is good")
-	return collection, nil
-}
\ No newline at end of file
+func GetPasswordResetCollection() (*mongo.Collection, error) {
+	return getCollection("passwordResets", "PASSWORD_RESET_DB")
+}
+
+func GetSocialCollection() (*mongo.Collection, error) {
+	return getCollection("social", "SOCIAL_DB")
+}
+
+func GetReportCollection() (*mongo.Collection, error) {
+	return getCollection("reports", "REPORT_DB")
+}
+
+func GetSkinCatalogCollection() (*mongo.Collection, error) {
+	return getCollection("skinCatalog", "SKIN_CATALOG_DB")
+}
+
+func GetLoadoutCollection() (*mongo.Collection, error) {
+	return getCollection("loadouts", "LOADOUT_DB")
+}
+
+func GetCashLedgerCollection() (*mongo.Collection, error) {
+	return getCollection("cashLedger", "CASH_LEDGER_DB")
+}
+
+func GetPlayerStatsCollection() (*mongo.Collection, error) {
+	return getCollection("playerStats", "PLAYER_STATS_DB")
+}
+
+func GetAchievementCollection() (*mongo.Collection, error) {
+	return getCollection("achievements", "ACHIEVEMENT_DB")
+}
+
+func GetMissionCollection() (*mongo.Collection, error) {
+	return getCollection("missions", "MISSION_DB")
+}
+
+func GetInventoryCollection() (*mongo.Collection, error) {
+	return getCollection("inventory", "INVENTORY_DB")
+}
+
+func GetGiftCollection() (*mongo.Collection, error) {
+	return getCollection("gifts", "GIFT_DB")
+}
+
+func GetGiftCapCollection() (*mongo.Collection, error) {
+	return getCollection("giftCaps", "GIFT_CAP_DB")
+}
+
+func GetShopRotationCollection() (*mongo.Collection, error) {
+	return getCollection("shopRotations", "SHOP_ROTATION_DB")
+}
+
+func GetShopEventCollection() (*mongo.Collection, error) {
+	return getCollection("shopEvents", "SHOP_EVENT_DB")
+}
+
+func GetShopStockCollection() (*mongo.Collection, error) {
+	return getCollection("shopStock", "SHOP_STOCK_DB")
+}
@@ -1,13 +1,34 @@
 package model
 
+import "time"
+
 type User struct {
 	PlayerID   string   `bson:"playerID"`
 	Name       string   `bson:"name"`
 	UserName   string   `bson:"userName"`
 	Password   string   `bson:"password"`
+	Email      string   `bson:"email"`
 	Cash       int      `bson:"cash"`
 	Rank 	   int 		`bson:"rank"`
 	RecordList []string `bson:"recordList"`				// list of record uuid
+	Guest      bool     `bson:"guest"`					// created by LoginAsGuest, no password until claimed
+	Role       string   `bson:"role"`					// "admin" can moderate
+	Warnings   int      `bson:"warnings"`
+	ChatMutedUntil time.Time `bson:"chatMutedUntil"`
+	BannedUntil    time.Time `bson:"bannedUntil"`
+	PermanentBan   bool      `bson:"permanentBan"`
+	BanReason      string    `bson:"banReason"`
+	LastDailyClaim string    `bson:"lastDailyClaim"`		// server day of the last daily reward, 2006-01-02
+	DailyStreak    int       `bson:"dailyStreak"`
+	CreatedAt      time.Time `bson:"createdAt"`			// set for guests, unclaimed guests are removed after guestLifetime
+}
+
+// PasswordReset -- single-use reset token, only the sha256 of the token is stored
+type PasswordReset struct {
+	TokenHash  string    `bson:"tokenHash"`
+	PlayerID   string    `bson:"playerID"`
+	ExpiresAt  time.Time `bson:"expiresAt"`
+	Used       bool      `bson:"used"`
 }
 
 type Record struct {
@@ -23,11 +44,168 @@ type RankingList struct {
 	TopRanking	[10]PlayerRank	`bson:"listHighScore"`	// 10 uuid of record
 }
 
+// Skin -- old per-player skin lists, copied into the inventory at startup and kept for reference
 type Skin struct {
 	PlayerID   	string   	`bson:"playerID"`
 	PuckSkin	[]int32		`bson:"puckSkin"`
 	StrikerSkin	[]int32		`bson:"strikerSkin"`
 	TableSkin	[]int32		`bson:"tableSkin"`
+	EmoteSkin	[]int32		`bson:"emoteSkin"`		// unlocked emotes, the free ones are not stored
+	Migrated	bool		`bson:"migrated"`		// already copied into the inventory
+}
+
+// InventoryItem -- one item a player owns, new item types need no schema change
+type InventoryItem struct {
+	PlayerID		string		`bson:"playerID"`
+	ItemType		int32		`bson:"itemType"`
+	ItemID			int32		`bson:"itemID"`
+	Quantity		int			`bson:"quantity"`		// 1 for items that are not consumed
+	AcquiredAt		time.Time	`bson:"acquiredAt"`
+}
+
+// CashTransaction -- one append-only entry of the cash ledger
+type CashTransaction struct {
+	TransactionID	string		`bson:"transactionID"`
+	PlayerID		string		`bson:"playerID"`
+	Amount			int			`bson:"amount"`			// positive credit, negative debit
+	Reason			string		`bson:"reason"`
+	Balance			int			`bson:"balance"`			// balance after the entry
+	Reference		string		`bson:"reference"`		// what the entry is for, e.g. the skin bought
+	CreatedAt		time.Time	`bson:"createdAt"`
+}
+
+// PlayerStats -- totals kept up to date each time a match is finalized
+type PlayerStats struct {
+	PlayerID		string		`bson:"playerID"`
+	MatchesPlayed	int			`bson:"matchesPlayed"`
+	Wins			int			`bson:"wins"`
+	Losses			int			`bson:"losses"`
+	Draws			int			`bson:"draws"`
+	WinStreak		int			`bson:"winStreak"`		// current run of wins
+	BestWinStreak	int			`bson:"bestWinStreak"`
+	GoalsFor		int			`bson:"goalsFor"`
+	GoalsAgainst	int			`bson:"goalsAgainst"`
+}
+
+// Achievement -- an achievement a player unlocked
+type Achievement struct {
+	PlayerID		string		`bson:"playerID"`
+	AchievementID	string		`bson:"achievementID"`
+	UnlockedAt		time.Time	`bson:"unlockedAt"`
+}
+
+// MissionProgress -- a player's progress on one mission for one daily or weekly period
+type MissionProgress struct {
+	PlayerID		string		`bson:"playerID"`
+	MissionID		string		`bson:"missionID"`
+	Period			string		`bson:"period"`			// 2006-01-02 for daily, 2006-W01 for weekly missions
+	Progress		int			`bson:"progress"`
+	Matches			[]string	`bson:"matches"`			// records already counted, a match adds progress once
+	Claimed			bool		`bson:"claimed"`
+	ClaimedAt		time.Time	`bson:"claimedAt"`
+}
+
+// GiftCap -- what a player sent on one day, reserved before a gift is stored
+type GiftCap struct {
+	PlayerID		string		`bson:"playerID"`
+	Day				string		`bson:"day"`				// 2006-01-02 in the reward time zone
+	Count			int			`bson:"count"`
+	Cash			int			`bson:"cash"`				// cash gifts only, skins are not included
+}
+
+// Gift -- a skin or cash sent to a friend, paid by the sender when sent
+type Gift struct {
+	GiftID			string		`bson:"giftID"`
+	FromPlayerID	string		`bson:"fromPlayerID"`
+	FromUserName	string		`bson:"fromUserName"`
+	ToPlayerID		string		`bson:"toPlayerID"`
+	ToUserName		string		`bson:"toUserName"`
+	SkinType		int32		`bson:"skinType"`			// 0 for a cash gift
+	SkinID			int32		`bson:"skinID"`
+	Cash			int			`bson:"cash"`				// what the sender paid, the skin price for a skin
+	RotationID		string		`bson:"rotationID"`		// set when the skin took limited shop stock
+	Status			string		`bson:"status"`			// pending, accepted, declined or expired
+	CreatedAt		time.Time	`bson:"createdAt"`
+	RespondedAt		time.Time	`bson:"respondedAt"`
+}
+
+// Loadout -- the skins a player has equipped, 0 is the default skin
+type Loadout struct {
+	PlayerID		string		`bson:"playerID"`
+	PuckSkin		int32		`bson:"puckSkin"`
+	StrikerSkin		int32		`bson:"strikerSkin"`
+	TableSkin		int32		`bson:"tableSkin"`
+}
+
+// CatalogSkin -- a skin players can own, SkinID is unique within its SkinType
+type CatalogSkin struct {
+	SkinType		int32		`bson:"skinType"`			// 1 puck, 2 striker, 3 table, 4 emote
+	SkinID			int32		`bson:"skinID"`
+	Name			string		`bson:"name"`
+	Price			int			`bson:"price"`				// 0 means it can be claimed with AddSkin
+	Rarity			string		`bson:"rarity"`
+	AvailableFrom	time.Time	`bson:"availableFrom"`		// zero means no start
+	AvailableUntil	time.Time	`bson:"availableUntil"`		// zero means no end
+}
+
+// ShopRotation -- featured items on sale between StartsAt and EndsAt
+type ShopRotation struct {
+	RotationID		string				`bson:"rotationID"`
+	StartsAt		time.Time			`bson:"startsAt"`
+	EndsAt			time.Time			`bson:"endsAt"`
+	Items			[]ShopRotationItem	`bson:"items"`
+}
+
+type ShopRotationItem struct {
+	SkinType		int32		`bson:"skinType"`
+	SkinID			int32		`bson:"skinID"`
+	DiscountPercent	int			`bson:"discountPercent"`
+	Stock			int			`bson:"stock"`			// 0 means unlimited
+}
+
+// ShopEvent -- a discount on every skin, or on some skin types, while it runs
+type ShopEvent struct {
+	EventID			string		`bson:"eventID"`
+	Name			string		`bson:"name"`
+	StartsAt		time.Time	`bson:"startsAt"`
+	EndsAt			time.Time	`bson:"endsAt"`
+	DiscountPercent	int			`bson:"discountPercent"`
+	SkinTypes		[]int32		`bson:"skinTypes"`		// empty means every type
+}
+
+// ShopStock -- copies of a limited rotation item sold so far, kept apart so reseeding does not reset it
+type ShopStock struct {
+	RotationID		string		`bson:"rotationID"`
+	SkinType		int32		`bson:"skinType"`
+	SkinID			int32		`bson:"skinID"`
+	Sold			int			`bson:"sold"`
+}
+
+// Report -- a player report and the context captured when it was made
+type Report struct {
+	ReportID		string		`bson:"reportID"`
+	ReporterID		string		`bson:"reporterID"`
+	ReporterUserName	string	`bson:"reporterUserName"`
+	UserName		string		`bson:"userName"`			// the reported player
+	Reason			int32		`bson:"reason"`
+	RoomID			string		`bson:"roomID"`
+	Details			string		`bson:"details"`
+	RecentRecords	[]Record	`bson:"recentRecords"`
+	CreatedAt		time.Time	`bson:"createdAt"`
+	Resolved		bool		`bson:"resolved"`
+	Action			int32		`bson:"action"`
+	ResolvedBy		string		`bson:"resolvedBy"`
+	ResolvedAt		time.Time	`bson:"resolvedAt"`
+}
+
+// Social -- friends, pending requests and block list of a user, all by userName
+type Social struct {
+	UserName	string		`bson:"userName"`
+	Friends		[]string	`bson:"friends"`
+	Incoming	[]string	`bson:"incoming"`		// requests waiting for this user
+	Outgoing	[]string	`bson:"outgoing"`		// requests this user sent
+	Blocked		[]string	`bson:"blocked"`
+	Muted		[]string	`bson:"muted"`			// chat from these users is not delivered
 }
 
 // PlayerRank -- supported model
@@ -24,13 +24,215 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ChatChannel int32
+
+const (
+	ChatChannel_ROOM  ChatChannel = 0
+	ChatChannel_LOBBY ChatChannel = 1 // every online player
+)
+
+// Enum value maps for ChatChannel.
+var (
+	ChatChannel_name = map[int32]string{
+		0: "ROOM",
+		1: "LOBBY",
+	}
+	ChatChannel_value = map[string]int32{
+		"ROOM":  0,
+		"LOBBY": 1,
+	}
+)
+
+func (x ChatChannel) Enum() *ChatChannel {
+	p := new(ChatChannel)
+	*p = x
+	return p
+}
+
+func (x ChatChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_airHockey_proto_enumTypes[0].Descriptor()
+}
+
+func (ChatChannel) Type() protoreflect.EnumType {
+	return &file_pb_airHockey_proto_enumTypes[0]
+}
+
+func (x ChatChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatChannel.Descriptor instead.
+func (ChatChannel) EnumDescriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{0}
+}
+
+type ReportReason int32
+
+const (
+	ReportReason_OTHER        ReportReason = 0
+	ReportReason_CHEATING     ReportReason = 1
+	ReportReason_ABUSIVE_CHAT ReportReason = 2
+	ReportReason_AFK          ReportReason = 3
+)
+
+// Enum value maps for ReportReason.
+var (
+	ReportReason_name = map[int32]string{
+		0: "OTHER",
+		1: "CHEATING",
+		2: "ABUSIVE_CHAT",
+		3: "AFK",
+	}
+	ReportReason_value = map[string]int32{
+		"OTHER":        0,
+		"CHEATING":     1,
+		"ABUSIVE_CHAT": 2,
+		"AFK":          3,
+	}
+)
+
+func (x ReportReason) Enum() *ReportReason {
+	p := new(ReportReason)
+	*p = x
+	return p
+}
+
+func (x ReportReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_airHockey_proto_enumTypes[1].Descriptor()
+}
+
+func (ReportReason) Type() protoreflect.EnumType {
+	return &file_pb_airHockey_proto_enumTypes[1]
+}
+
+func (x ReportReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportReason.Descriptor instead.
+func (ReportReason) EnumDescriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{1}
+}
+
+type ModerationAction int32
+
+const (
+	ModerationAction_WARN          ModerationAction = 0
+	ModerationAction_MUTE_CHAT     ModerationAction = 1
+	ModerationAction_TEMP_BAN      ModerationAction = 2
+	ModerationAction_PERMANENT_BAN ModerationAction = 3
+)
+
+// Enum value maps for ModerationAction.
+var (
+	ModerationAction_name = map[int32]string{
+		0: "WARN",
+		1: "MUTE_CHAT",
+		2: "TEMP_BAN",
+		3: "PERMANENT_BAN",
+	}
+	ModerationAction_value = map[string]int32{
+		"WARN":          0,
+		"MUTE_CHAT":     1,
+		"TEMP_BAN":      2,
+		"PERMANENT_BAN": 3,
+	}
+)
+
+func (x ModerationAction) Enum() *ModerationAction {
+	p := new(ModerationAction)
+	*p = x
+	return p
+}
+
+func (x ModerationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_airHockey_proto_enumTypes[2].Descriptor()
+}
+
+func (ModerationAction) Type() protoreflect.EnumType {
+	return &file_pb_airHockey_proto_enumTypes[2]
+}
+
+func (x ModerationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationAction.Descriptor instead.
+func (ModerationAction) EnumDescriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{2}
+}
+
+type PresenceStatus int32
+
+const (
+	PresenceStatus_OFFLINE  PresenceStatus = 0
+	PresenceStatus_ONLINE   PresenceStatus = 1 // logged in, not in a room
+	PresenceStatus_IN_LOBBY PresenceStatus = 2 // in a room waiting for the match
+	PresenceStatus_IN_MATCH PresenceStatus = 3
+)
+
+// Enum value maps for PresenceStatus.
+var (
+	PresenceStatus_name = map[int32]string{
+		0: "OFFLINE",
+		1: "ONLINE",
+		2: "IN_LOBBY",
+		3: "IN_MATCH",
+	}
+	PresenceStatus_value = map[string]int32{
+		"OFFLINE":  0,
+		"ONLINE":   1,
+		"IN_LOBBY": 2,
+		"IN_MATCH": 3,
+	}
+)
+
+func (x PresenceStatus) Enum() *PresenceStatus {
+	p := new(PresenceStatus)
+	*p = x
+	return p
+}
+
+func (x PresenceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PresenceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_airHockey_proto_enumTypes[3].Descriptor()
+}
+
+func (PresenceStatus) Type() protoreflect.EnumType {
+	return &file_pb_airHockey_proto_enumTypes[3]
+}
+
+func (x PresenceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PresenceStatus.Descriptor instead.
+func (PresenceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{3}
+}
+
 type AddNewSkin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	PlayerID string `protobuf:"bytes,1,opt,name=PlayerID,proto3" json:"PlayerID,omitempty"`
-	SkinType int32  `protobuf:"varint,2,opt,name=SkinType,proto3" json:"SkinType,omitempty"`
+	SkinType int32  `protobuf:"varint,2,opt,name=SkinType,proto3" json:"SkinType,omitempty"` // an item type: 1 puck, 2 striker, 3 table, 4 emote, ...
 	SkinID   int32  `protobuf:"varint,3,opt,name=SkinID,proto3" json:"SkinID,omitempty"`
 }
 
@@ -87,18 +289,18 @@ func (x *AddNewSkin) GetSkinID() int32 {
 	return 0
 }
 
-type SkinList struct {
+type InventoryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PuckIDList    []int32 `protobuf:"varint,1,rep,packed,name=puckIDList,proto3" json:"puckIDList,omitempty"`
-	StrikerIDList []int32 `protobuf:"varint,2,rep,packed,name=strikerIDList,proto3" json:"strikerIDList,omitempty"`
-	TableIDList   []int32 `protobuf:"varint,3,rep,packed,name=tableIDList,proto3" json:"tableIDList,omitempty"`
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	ItemType     int32  `protobuf:"varint,3,opt,name=itemType,proto3" json:"itemType,omitempty"` // 0 for every type
 }
 
-func (x *SkinList) Reset() {
-	*x = SkinList{}
+func (x *InventoryReq) Reset() {
+	*x = InventoryReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -106,13 +308,13 @@ func (x *SkinList) Reset() {
 	}
 }
 
-func (x *SkinList) String() string {
+func (x *InventoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SkinList) ProtoMessage() {}
+func (*InventoryReq) ProtoMessage() {}
 
-func (x *SkinList) ProtoReflect() protoreflect.Message {
+func (x *InventoryReq) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -124,45 +326,45 @@ func (x *SkinList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SkinList.ProtoReflect.Descriptor instead.
-func (*SkinList) Descriptor() ([]byte, []int) {
+// Deprecated: Use InventoryReq.ProtoReflect.Descriptor instead.
+func (*InventoryReq) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *SkinList) GetPuckIDList() []int32 {
+func (x *InventoryReq) GetUuid() string {
 	if x != nil {
-		return x.PuckIDList
+		return x.Uuid
 	}
-	return nil
+	return ""
 }
 
-func (x *SkinList) GetStrikerIDList() []int32 {
+func (x *InventoryReq) GetSessionToken() string {
 	if x != nil {
-		return x.StrikerIDList
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-func (x *SkinList) GetTableIDList() []int32 {
+func (x *InventoryReq) GetItemType() int32 {
 	if x != nil {
-		return x.TableIDList
+		return x.ItemType
 	}
-	return nil
+	return 0
 }
 
-type RankAndCash struct {
+type InventoryItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerName string `protobuf:"bytes,1,opt,name=PlayerName,proto3" json:"PlayerName,omitempty"`
-	PlayerID   string `protobuf:"bytes,2,opt,name=PlayerID,proto3" json:"PlayerID,omitempty"`
-	Cash       int32  `protobuf:"varint,3,opt,name=Cash,proto3" json:"Cash,omitempty"`
-	Rank       int32  `protobuf:"varint,4,opt,name=Rank,proto3" json:"Rank,omitempty"`
+	ItemType   int32 `protobuf:"varint,1,opt,name=itemType,proto3" json:"itemType,omitempty"` // 1 puck, 2 striker, 3 table, 4 emote, 5 goal effect, 6 title, 7 banner
+	ItemID     int32 `protobuf:"varint,2,opt,name=itemID,proto3" json:"itemID,omitempty"`
+	Quantity   int32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // always 1 for items that are not consumed
+	AcquiredAt int64 `protobuf:"varint,4,opt,name=acquiredAt,proto3" json:"acquiredAt,omitempty"`
 }
 
-func (x *RankAndCash) Reset() {
-	*x = RankAndCash{}
+func (x *InventoryItem) Reset() {
+	*x = InventoryItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -170,13 +372,13 @@ func (x *RankAndCash) Reset() {
 	}
 }
 
-func (x *RankAndCash) String() string {
+func (x *InventoryItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RankAndCash) ProtoMessage() {}
+func (*InventoryItem) ProtoMessage() {}
 
-func (x *RankAndCash) ProtoReflect() protoreflect.Message {
+func (x *InventoryItem) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -188,49 +390,49 @@ func (x *RankAndCash) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RankAndCash.ProtoReflect.Descriptor instead.
-func (*RankAndCash) Descriptor() ([]byte, []int) {
+// Deprecated: Use InventoryItem.ProtoReflect.Descriptor instead.
+func (*InventoryItem) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *RankAndCash) GetPlayerName() string {
+func (x *InventoryItem) GetItemType() int32 {
 	if x != nil {
-		return x.PlayerName
+		return x.ItemType
 	}
-	return ""
+	return 0
 }
 
-func (x *RankAndCash) GetPlayerID() string {
+func (x *InventoryItem) GetItemID() int32 {
 	if x != nil {
-		return x.PlayerID
+		return x.ItemID
 	}
-	return ""
+	return 0
 }
 
-func (x *RankAndCash) GetCash() int32 {
+func (x *InventoryItem) GetQuantity() int32 {
 	if x != nil {
-		return x.Cash
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *RankAndCash) GetRank() int32 {
+func (x *InventoryItem) GetAcquiredAt() int64 {
 	if x != nil {
-		return x.Rank
+		return x.AcquiredAt
 	}
 	return 0
 }
 
-type RankingList struct {
+type Inventory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RankingList []*PlayerRank `protobuf:"bytes,1,rep,name=rankingList,proto3" json:"rankingList,omitempty"`
+	Items []*InventoryItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *RankingList) Reset() {
-	*x = RankingList{}
+func (x *Inventory) Reset() {
+	*x = Inventory{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -238,13 +440,13 @@ func (x *RankingList) Reset() {
 	}
 }
 
-func (x *RankingList) String() string {
+func (x *Inventory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RankingList) ProtoMessage() {}
+func (*Inventory) ProtoMessage() {}
 
-func (x *RankingList) ProtoReflect() protoreflect.Message {
+func (x *Inventory) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -256,29 +458,33 @@ func (x *RankingList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RankingList.ProtoReflect.Descriptor instead.
-func (*RankingList) Descriptor() ([]byte, []int) {
+// Deprecated: Use Inventory.ProtoReflect.Descriptor instead.
+func (*Inventory) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *RankingList) GetRankingList() []*PlayerRank {
+func (x *Inventory) GetItems() []*InventoryItem {
 	if x != nil {
-		return x.RankingList
+		return x.Items
 	}
 	return nil
 }
 
-type PlayerRank struct {
+type GrantItemReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerName string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
-	RankScore  int32  `protobuf:"varint,2,opt,name=rankScore,proto3" json:"rankScore,omitempty"`
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // the admin
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	UserName     string `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	ItemType     int32  `protobuf:"varint,4,opt,name=itemType,proto3" json:"itemType,omitempty"`
+	ItemID       int32  `protobuf:"varint,5,opt,name=itemID,proto3" json:"itemID,omitempty"`
+	Quantity     int32  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"` // consumables only, others are granted once
 }
 
-func (x *PlayerRank) Reset() {
-	*x = PlayerRank{}
+func (x *GrantItemReq) Reset() {
+	*x = GrantItemReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -286,13 +492,13 @@ func (x *PlayerRank) Reset() {
 	}
 }
 
-func (x *PlayerRank) String() string {
+func (x *GrantItemReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerRank) ProtoMessage() {}
+func (*GrantItemReq) ProtoMessage() {}
 
-func (x *PlayerRank) ProtoReflect() protoreflect.Message {
+func (x *GrantItemReq) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -304,38 +510,66 @@ func (x *PlayerRank) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerRank.ProtoReflect.Descriptor instead.
-func (*PlayerRank) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrantItemReq.ProtoReflect.Descriptor instead.
+func (*GrantItemReq) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *PlayerRank) GetPlayerName() string {
+func (x *GrantItemReq) GetUuid() string {
 	if x != nil {
-		return x.PlayerName
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *PlayerRank) GetRankScore() int32 {
+func (x *GrantItemReq) GetSessionToken() string {
 	if x != nil {
-		return x.RankScore
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GrantItemReq) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *GrantItemReq) GetItemType() int32 {
+	if x != nil {
+		return x.ItemType
 	}
 	return 0
 }
 
-type Record struct {
+func (x *GrantItemReq) GetItemID() int32 {
+	if x != nil {
+		return x.ItemID
+	}
+	return 0
+}
+
+func (x *GrantItemReq) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type SkinList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RecordTime string   `protobuf:"bytes,1,opt,name=recordTime,proto3" json:"recordTime,omitempty"`
-	Team1      []string `protobuf:"bytes,2,rep,name=team1,proto3" json:"team1,omitempty"`
-	Team2      []string `protobuf:"bytes,3,rep,name=team2,proto3" json:"team2,omitempty"`
-	MatchScore []int32  `protobuf:"varint,4,rep,packed,name=matchScore,proto3" json:"matchScore,omitempty"`
+	PuckIDList    []int32 `protobuf:"varint,1,rep,packed,name=puckIDList,proto3" json:"puckIDList,omitempty"`
+	StrikerIDList []int32 `protobuf:"varint,2,rep,packed,name=strikerIDList,proto3" json:"strikerIDList,omitempty"`
+	TableIDList   []int32 `protobuf:"varint,3,rep,packed,name=tableIDList,proto3" json:"tableIDList,omitempty"`
+	EmoteIDList   []int32 `protobuf:"varint,4,rep,packed,name=emoteIDList,proto3" json:"emoteIDList,omitempty"`
 }
 
-func (x *Record) Reset() {
-	*x = Record{}
+func (x *SkinList) Reset() {
+	*x = SkinList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -343,13 +577,13 @@ func (x *Record) Reset() {
 	}
 }
 
-func (x *Record) String() string {
+func (x *SkinList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Record) ProtoMessage() {}
+func (*SkinList) ProtoMessage() {}
 
-func (x *Record) ProtoReflect() protoreflect.Message {
+func (x *SkinList) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -361,49 +595,55 @@ func (x *Record) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Record.ProtoReflect.Descriptor instead.
-func (*Record) Descriptor() ([]byte, []int) {
+// Deprecated: Use SkinList.ProtoReflect.Descriptor instead.
+func (*SkinList) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Record) GetRecordTime() string {
+func (x *SkinList) GetPuckIDList() []int32 {
 	if x != nil {
-		return x.RecordTime
+		return x.PuckIDList
 	}
-	return ""
+	return nil
 }
 
-func (x *Record) GetTeam1() []string {
+func (x *SkinList) GetStrikerIDList() []int32 {
 	if x != nil {
-		return x.Team1
+		return x.StrikerIDList
 	}
 	return nil
 }
 
-func (x *Record) GetTeam2() []string {
+func (x *SkinList) GetTableIDList() []int32 {
 	if x != nil {
-		return x.Team2
+		return x.TableIDList
 	}
 	return nil
 }
 
-func (x *Record) GetMatchScore() []int32 {
+func (x *SkinList) GetEmoteIDList() []int32 {
 	if x != nil {
-		return x.MatchScore
+		return x.EmoteIDList
 	}
 	return nil
 }
 
-type RecordID struct {
+type CatalogSkin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SkinType       int32  `protobuf:"varint,1,opt,name=SkinType,proto3" json:"SkinType,omitempty"`
+	SkinID         int32  `protobuf:"varint,2,opt,name=SkinID,proto3" json:"SkinID,omitempty"`
+	Name           string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price          int32  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Rarity         string `protobuf:"bytes,5,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	AvailableFrom  int64  `protobuf:"varint,6,opt,name=availableFrom,proto3" json:"availableFrom,omitempty"` // unix seconds, 0 means no bound
+	AvailableUntil int64  `protobuf:"varint,7,opt,name=availableUntil,proto3" json:"availableUntil,omitempty"`
 }
 
-func (x *RecordID) Reset() {
-	*x = RecordID{}
+func (x *CatalogSkin) Reset() {
+	*x = CatalogSkin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,13 +651,13 @@ func (x *RecordID) Reset() {
 	}
 }
 
-func (x *RecordID) String() string {
+func (x *CatalogSkin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordID) ProtoMessage() {}
+func (*CatalogSkin) ProtoMessage() {}
 
-func (x *RecordID) ProtoReflect() protoreflect.Message {
+func (x *CatalogSkin) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -429,43 +669,89 @@ func (x *RecordID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordID.ProtoReflect.Descriptor instead.
-func (*RecordID) Descriptor() ([]byte, []int) {
+// Deprecated: Use CatalogSkin.ProtoReflect.Descriptor instead.
+func (*CatalogSkin) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RecordID) GetUuid() string {
+func (x *CatalogSkin) GetSkinType() int32 {
 	if x != nil {
-		return x.Uuid
+		return x.SkinType
 	}
-	return ""
+	return 0
 }
 
-type NewAccountReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	AccountInfo *Account `protobuf:"bytes,2,opt,name=accountInfo,proto3" json:"accountInfo,omitempty"`
+func (x *CatalogSkin) GetSkinID() int32 {
+	if x != nil {
+		return x.SkinID
+	}
+	return 0
 }
 
-func (x *NewAccountReq) Reset() {
-	*x = NewAccountReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_airHockey_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CatalogSkin) GetName() string {
+	if x != nil {
+		return x.Name
 	}
+	return ""
 }
 
-func (x *NewAccountReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CatalogSkin) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (*NewAccountReq) ProtoMessage() {}
+func (x *CatalogSkin) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
 
-func (x *NewAccountReq) ProtoReflect() protoreflect.Message {
+func (x *CatalogSkin) GetAvailableFrom() int64 {
+	if x != nil {
+		return x.AvailableFrom
+	}
+	return 0
+}
+
+func (x *CatalogSkin) GetAvailableUntil() int64 {
+	if x != nil {
+		return x.AvailableUntil
+	}
+	return 0
+}
+
+type ShopOffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Skin            *CatalogSkin `protobuf:"bytes,1,opt,name=skin,proto3" json:"skin,omitempty"`
+	Price           int32        `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"` // after discounts
+	DiscountPercent int32        `protobuf:"varint,3,opt,name=discountPercent,proto3" json:"discountPercent,omitempty"`
+	Stock           int32        `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"` // 0 for unlimited
+	Remaining       int32        `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	EndsAt          int64        `protobuf:"varint,6,opt,name=endsAt,proto3" json:"endsAt,omitempty"` // unix seconds, when the rotation changes
+}
+
+func (x *ShopOffer) Reset() {
+	*x = ShopOffer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShopOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShopOffer) ProtoMessage() {}
+
+func (x *ShopOffer) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -477,36 +763,67 @@ func (x *NewAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewAccountReq.ProtoReflect.Descriptor instead.
-func (*NewAccountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShopOffer.ProtoReflect.Descriptor instead.
+func (*ShopOffer) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *NewAccountReq) GetName() string {
+func (x *ShopOffer) GetSkin() *CatalogSkin {
 	if x != nil {
-		return x.Name
+		return x.Skin
 	}
-	return ""
+	return nil
 }
 
-func (x *NewAccountReq) GetAccountInfo() *Account {
+func (x *ShopOffer) GetPrice() int32 {
 	if x != nil {
-		return x.AccountInfo
+		return x.Price
 	}
-	return nil
+	return 0
 }
 
-type Account struct {
+func (x *ShopOffer) GetDiscountPercent() int32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *ShopOffer) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ShopOffer) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *ShopOffer) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+type ShopEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserName string `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	EventID         string  `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Name            string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DiscountPercent int32   `protobuf:"varint,3,opt,name=discountPercent,proto3" json:"discountPercent,omitempty"`
+	SkinTypes       []int32 `protobuf:"varint,4,rep,packed,name=skinTypes,proto3" json:"skinTypes,omitempty"` // empty for every type
+	EndsAt          int64   `protobuf:"varint,5,opt,name=endsAt,proto3" json:"endsAt,omitempty"`
 }
 
-func (x *Account) Reset() {
-	*x = Account{}
+func (x *ShopEvent) Reset() {
+	*x = ShopEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -514,13 +831,13 @@ func (x *Account) Reset() {
 	}
 }
 
-func (x *Account) String() string {
+func (x *ShopEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Account) ProtoMessage() {}
+func (*ShopEvent) ProtoMessage() {}
 
-func (x *Account) ProtoReflect() protoreflect.Message {
+func (x *ShopEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,41 +849,57 @@ func (x *Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Account.ProtoReflect.Descriptor instead.
-func (*Account) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShopEvent.ProtoReflect.Descriptor instead.
+func (*ShopEvent) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Account) GetUserName() string {
+func (x *ShopEvent) GetEventID() string {
 	if x != nil {
-		return x.UserName
+		return x.EventID
 	}
 	return ""
 }
 
-func (x *Account) GetPassword() string {
+func (x *ShopEvent) GetName() string {
 	if x != nil {
-		return x.Password
+		return x.Name
 	}
 	return ""
 }
 
-type GameMessage struct {
+func (x *ShopEvent) GetDiscountPercent() int32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *ShopEvent) GetSkinTypes() []int32 {
+	if x != nil {
+		return x.SkinTypes
+	}
+	return nil
+}
+
+func (x *ShopEvent) GetEndsAt() int64 {
+	if x != nil {
+		return x.EndsAt
+	}
+	return 0
+}
+
+type Shop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Action:
-	//	*GameMessage_PlayerInput
-	//	*GameMessage_EntityState
-	//	*GameMessage_GameState
-	//	*GameMessage_Empty
-	Action isGameMessage_Action `protobuf_oneof:"action"`
-	Sender string               `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	Featured []*ShopOffer `protobuf:"bytes,1,rep,name=featured,proto3" json:"featured,omitempty"`
+	Events   []*ShopEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (x *GameMessage) Reset() {
-	*x = GameMessage{}
+func (x *Shop) Reset() {
+	*x = Shop{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -574,13 +907,13 @@ func (x *GameMessage) Reset() {
 	}
 }
 
-func (x *GameMessage) String() string {
+func (x *Shop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameMessage) ProtoMessage() {}
+func (*Shop) ProtoMessage() {}
 
-func (x *GameMessage) ProtoReflect() protoreflect.Message {
+func (x *Shop) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -592,93 +925,35 @@ func (x *GameMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameMessage.ProtoReflect.Descriptor instead.
-func (*GameMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use Shop.ProtoReflect.Descriptor instead.
+func (*Shop) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{9}
 }
 
-func (m *GameMessage) GetAction() isGameMessage_Action {
-	if m != nil {
-		return m.Action
-	}
-	return nil
-}
-
-func (x *GameMessage) GetPlayerInput() *PlayerInput {
-	if x, ok := x.GetAction().(*GameMessage_PlayerInput); ok {
-		return x.PlayerInput
-	}
-	return nil
-}
-
-func (x *GameMessage) GetEntityState() *EntityState {
-	if x, ok := x.GetAction().(*GameMessage_EntityState); ok {
-		return x.EntityState
-	}
-	return nil
-}
-
-func (x *GameMessage) GetGameState() *GameState {
-	if x, ok := x.GetAction().(*GameMessage_GameState); ok {
-		return x.GameState
-	}
-	return nil
-}
-
-func (x *GameMessage) GetEmpty() *Empty {
-	if x, ok := x.GetAction().(*GameMessage_Empty); ok {
-		return x.Empty
+func (x *Shop) GetFeatured() []*ShopOffer {
+	if x != nil {
+		return x.Featured
 	}
 	return nil
 }
 
-func (x *GameMessage) GetSender() string {
+func (x *Shop) GetEvents() []*ShopEvent {
 	if x != nil {
-		return x.Sender
+		return x.Events
 	}
-	return ""
-}
-
-type isGameMessage_Action interface {
-	isGameMessage_Action()
-}
-
-type GameMessage_PlayerInput struct {
-	PlayerInput *PlayerInput `protobuf:"bytes,1,opt,name=playerInput,proto3,oneof"`
-}
-
-type GameMessage_EntityState struct {
-	EntityState *EntityState `protobuf:"bytes,2,opt,name=entityState,proto3,oneof"`
-}
-
-type GameMessage_GameState struct {
-	GameState *GameState `protobuf:"bytes,3,opt,name=gameState,proto3,oneof"`
-}
-
-type GameMessage_Empty struct {
-	Empty *Empty `protobuf:"bytes,4,opt,name=empty,proto3,oneof"`
+	return nil
 }
 
-func (*GameMessage_PlayerInput) isGameMessage_Action() {}
-
-func (*GameMessage_EntityState) isGameMessage_Action() {}
-
-func (*GameMessage_GameState) isGameMessage_Action() {}
-
-func (*GameMessage_Empty) isGameMessage_Action() {}
-
-type PlayerInput struct {
+type SkinCatalog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Direction *Direction `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"`
-	Sender    string     `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	RoomID    string     `protobuf:"bytes,3,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	Skins []*CatalogSkin `protobuf:"bytes,1,rep,name=skins,proto3" json:"skins,omitempty"`
 }
 
-func (x *PlayerInput) Reset() {
-	*x = PlayerInput{}
+func (x *SkinCatalog) Reset() {
+	*x = SkinCatalog{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -686,13 +961,13 @@ func (x *PlayerInput) Reset() {
 	}
 }
 
-func (x *PlayerInput) String() string {
+func (x *SkinCatalog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerInput) ProtoMessage() {}
+func (*SkinCatalog) ProtoMessage() {}
 
-func (x *PlayerInput) ProtoReflect() protoreflect.Message {
+func (x *SkinCatalog) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -704,45 +979,31 @@ func (x *PlayerInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerInput.ProtoReflect.Descriptor instead.
-func (*PlayerInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use SkinCatalog.ProtoReflect.Descriptor instead.
+func (*SkinCatalog) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PlayerInput) GetDirection() *Direction {
+func (x *SkinCatalog) GetSkins() []*CatalogSkin {
 	if x != nil {
-		return x.Direction
+		return x.Skins
 	}
 	return nil
 }
 
-func (x *PlayerInput) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *PlayerInput) GetRoomID() string {
-	if x != nil {
-		return x.RoomID
-	}
-	return ""
-}
-
-type EntityState struct {
+type PurchaseSkinReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Players []*ObjectState `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
-	Puck    *ObjectState   `protobuf:"bytes,2,opt,name=puck,proto3" json:"puck,omitempty"`
-	Sender  string         `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
-	RoomID  string         `protobuf:"bytes,4,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	SkinType     int32  `protobuf:"varint,3,opt,name=SkinType,proto3" json:"SkinType,omitempty"`
+	SkinID       int32  `protobuf:"varint,4,opt,name=SkinID,proto3" json:"SkinID,omitempty"`
 }
 
-func (x *EntityState) Reset() {
-	*x = EntityState{}
+func (x *PurchaseSkinReq) Reset() {
+	*x = PurchaseSkinReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -750,13 +1011,13 @@ func (x *EntityState) Reset() {
 	}
 }
 
-func (x *EntityState) String() string {
+func (x *PurchaseSkinReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityState) ProtoMessage() {}
+func (*PurchaseSkinReq) ProtoMessage() {}
 
-func (x *EntityState) ProtoReflect() protoreflect.Message {
+func (x *PurchaseSkinReq) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -768,52 +1029,50 @@ func (x *EntityState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityState.ProtoReflect.Descriptor instead.
-func (*EntityState) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurchaseSkinReq.ProtoReflect.Descriptor instead.
+func (*PurchaseSkinReq) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *EntityState) GetPlayers() []*ObjectState {
+func (x *PurchaseSkinReq) GetUuid() string {
 	if x != nil {
-		return x.Players
+		return x.Uuid
 	}
-	return nil
+	return ""
 }
 
-func (x *EntityState) GetPuck() *ObjectState {
+func (x *PurchaseSkinReq) GetSessionToken() string {
 	if x != nil {
-		return x.Puck
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-func (x *EntityState) GetSender() string {
+func (x *PurchaseSkinReq) GetSkinType() int32 {
 	if x != nil {
-		return x.Sender
+		return x.SkinType
 	}
-	return ""
+	return 0
 }
 
-func (x *EntityState) GetRoomID() string {
+func (x *PurchaseSkinReq) GetSkinID() int32 {
 	if x != nil {
-		return x.RoomID
+		return x.SkinID
 	}
-	return ""
+	return 0
 }
 
-type GameState struct {
+type PurchaseResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsPlaying  int32  `protobuf:"varint,1,opt,name=isPlaying,proto3" json:"isPlaying,omitempty"`
-	ScoreTeam1 int32  `protobuf:"varint,2,opt,name=scoreTeam1,proto3" json:"scoreTeam1,omitempty"`
-	ScoreTeam2 int32  `protobuf:"varint,3,opt,name=scoreTeam2,proto3" json:"scoreTeam2,omitempty"`
-	RoomID     string `protobuf:"bytes,4,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
+	Cash  int32     `protobuf:"varint,1,opt,name=cash,proto3" json:"cash,omitempty"` // balance after the purchase
+	Skins *SkinList `protobuf:"bytes,2,opt,name=skins,proto3" json:"skins,omitempty"`
 }
 
-func (x *GameState) Reset() {
-	*x = GameState{}
+func (x *PurchaseResult) Reset() {
+	*x = PurchaseResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -821,13 +1080,13 @@ func (x *GameState) Reset() {
 	}
 }
 
-func (x *GameState) String() string {
+func (x *PurchaseResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameState) ProtoMessage() {}
+func (*PurchaseResult) ProtoMessage() {}
 
-func (x *GameState) ProtoReflect() protoreflect.Message {
+func (x *PurchaseResult) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -839,64 +1098,53 @@ func (x *GameState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
-func (*GameState) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurchaseResult.ProtoReflect.Descriptor instead.
+func (*PurchaseResult) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GameState) GetIsPlaying() int32 {
-	if x != nil {
-		return x.IsPlaying
-	}
-	return 0
-}
-
-func (x *GameState) GetScoreTeam1() int32 {
-	if x != nil {
-		return x.ScoreTeam1
-	}
-	return 0
-}
-
-func (x *GameState) GetScoreTeam2() int32 {
+func (x *PurchaseResult) GetCash() int32 {
 	if x != nil {
-		return x.ScoreTeam2
+		return x.Cash
 	}
 	return 0
 }
 
-func (x *GameState) GetRoomID() string {
+func (x *PurchaseResult) GetSkins() *SkinList {
 	if x != nil {
-		return x.RoomID
+		return x.Skins
 	}
-	return ""
+	return nil
 }
 
-type LeaveRequest struct {
+type RankAndCash struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomID     string      `protobuf:"bytes,1,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
-	PlayerInfo *PlayerInfo `protobuf:"bytes,2,opt,name=playerInfo,proto3" json:"playerInfo,omitempty"`
+	PlayerName   string `protobuf:"bytes,1,opt,name=PlayerName,proto3" json:"PlayerName,omitempty"`
+	PlayerID     string `protobuf:"bytes,2,opt,name=PlayerID,proto3" json:"PlayerID,omitempty"`
+	Cash         int32  `protobuf:"varint,3,opt,name=Cash,proto3" json:"Cash,omitempty"` // ignored, match rewards are paid by the server
+	Rank         int32  `protobuf:"varint,4,opt,name=Rank,proto3" json:"Rank,omitempty"`
+	SessionToken string `protobuf:"bytes,5,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
 }
 
-func (x *LeaveRequest) Reset() {
-	*x = LeaveRequest{}
-	if protoimpl.UnsafeEnabled {
+func (x *RankAndCash) Reset() {
+	*x = RankAndCash{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeaveRequest) String() string {
+func (x *RankAndCash) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeaveRequest) ProtoMessage() {}
+func (*RankAndCash) ProtoMessage() {}
 
-func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
+func (x *RankAndCash) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -908,36 +1156,59 @@ func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeaveRequest.ProtoReflect.Descriptor instead.
-func (*LeaveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RankAndCash.ProtoReflect.Descriptor instead.
+func (*RankAndCash) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *LeaveRequest) GetRoomID() string {
+func (x *RankAndCash) GetPlayerName() string {
 	if x != nil {
-		return x.RoomID
+		return x.PlayerName
 	}
 	return ""
 }
 
-func (x *LeaveRequest) GetPlayerInfo() *PlayerInfo {
+func (x *RankAndCash) GetPlayerID() string {
 	if x != nil {
-		return x.PlayerInfo
+		return x.PlayerID
 	}
-	return nil
+	return ""
 }
 
-type JoinRequest struct {
+func (x *RankAndCash) GetCash() int32 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *RankAndCash) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *RankAndCash) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type TransactionsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomID     string      `protobuf:"bytes,1,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
-	PlayerInfo *PlayerInfo `protobuf:"bytes,2,opt,name=playerInfo,proto3" json:"playerInfo,omitempty"`
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	Limit        int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`   // 0 uses the default page size
+	Before       int64  `protobuf:"varint,4,opt,name=before,proto3" json:"before,omitempty"` // unix seconds, 0 starts from the newest entry
 }
 
-func (x *JoinRequest) Reset() {
-	*x = JoinRequest{}
+func (x *TransactionsReq) Reset() {
+	*x = TransactionsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -945,13 +1216,13 @@ func (x *JoinRequest) Reset() {
 	}
 }
 
-func (x *JoinRequest) String() string {
+func (x *TransactionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinRequest) ProtoMessage() {}
+func (*TransactionsReq) ProtoMessage() {}
 
-func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+func (x *TransactionsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -963,37 +1234,54 @@ func (x *JoinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
-func (*JoinRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransactionsReq.ProtoReflect.Descriptor instead.
+func (*TransactionsReq) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *JoinRequest) GetRoomID() string {
+func (x *TransactionsReq) GetUuid() string {
 	if x != nil {
-		return x.RoomID
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *JoinRequest) GetPlayerInfo() *PlayerInfo {
+func (x *TransactionsReq) GetSessionToken() string {
 	if x != nil {
-		return x.PlayerInfo
+		return x.SessionToken
 	}
-	return nil
+	return ""
 }
 
-type NewGameInfo struct {
+func (x *TransactionsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *TransactionsReq) GetBefore() int64 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+type CashTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NumberOfPlayer int32  `protobuf:"varint,1,opt,name=numberOfPlayer,proto3" json:"numberOfPlayer,omitempty"`
-	TargetScore    int32  `protobuf:"varint,2,opt,name=targetScore,proto3" json:"targetScore,omitempty"`
-	Host           string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	TransactionID string `protobuf:"bytes,1,opt,name=transactionID,proto3" json:"transactionID,omitempty"`
+	Amount        int32  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // positive credit, negative debit
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Balance       int32  `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"` // balance after the entry
+	Reference     string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 }
 
-func (x *NewGameInfo) Reset() {
-	*x = NewGameInfo{}
+func (x *CashTransaction) Reset() {
+	*x = CashTransaction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1001,13 +1289,13 @@ func (x *NewGameInfo) Reset() {
 	}
 }
 
-func (x *NewGameInfo) String() string {
+func (x *CashTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewGameInfo) ProtoMessage() {}
+func (*CashTransaction) ProtoMessage() {}
 
-func (x *NewGameInfo) ProtoReflect() protoreflect.Message {
+func (x *CashTransaction) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1019,42 +1307,63 @@ func (x *NewGameInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewGameInfo.ProtoReflect.Descriptor instead.
-func (*NewGameInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use CashTransaction.ProtoReflect.Descriptor instead.
+func (*CashTransaction) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *NewGameInfo) GetNumberOfPlayer() int32 {
+func (x *CashTransaction) GetTransactionID() string {
 	if x != nil {
-		return x.NumberOfPlayer
+		return x.TransactionID
+	}
+	return ""
+}
+
+func (x *CashTransaction) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *NewGameInfo) GetTargetScore() int32 {
+func (x *CashTransaction) GetReason() string {
 	if x != nil {
-		return x.TargetScore
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CashTransaction) GetBalance() int32 {
+	if x != nil {
+		return x.Balance
 	}
 	return 0
 }
 
-func (x *NewGameInfo) GetHost() string {
+func (x *CashTransaction) GetReference() string {
 	if x != nil {
-		return x.Host
+		return x.Reference
 	}
 	return ""
 }
 
-type RoomID struct {
+func (x *CashTransaction) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type TransactionList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UniqueID string `protobuf:"bytes,1,opt,name=uniqueID,proto3" json:"uniqueID,omitempty"`
+	Transactions []*CashTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *RoomID) Reset() {
-	*x = RoomID{}
+func (x *TransactionList) Reset() {
+	*x = TransactionList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1062,13 +1371,13 @@ func (x *RoomID) Reset() {
 	}
 }
 
-func (x *RoomID) String() string {
+func (x *TransactionList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoomID) ProtoMessage() {}
+func (*TransactionList) ProtoMessage() {}
 
-func (x *RoomID) ProtoReflect() protoreflect.Message {
+func (x *TransactionList) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1080,33 +1389,32 @@ func (x *RoomID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoomID.ProtoReflect.Descriptor instead.
-func (*RoomID) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransactionList.ProtoReflect.Descriptor instead.
+func (*TransactionList) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RoomID) GetUniqueID() string {
+func (x *TransactionList) GetTransactions() []*CashTransaction {
 	if x != nil {
-		return x.UniqueID
+		return x.Transactions
 	}
-	return ""
+	return nil
 }
 
-type ObjectState struct {
+type GrantCashReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	X  float32 `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y  float32 `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
-	Z  float32 `protobuf:"fixed32,3,opt,name=z,proto3" json:"z,omitempty"`
-	Vx float32 `protobuf:"fixed32,4,opt,name=vx,proto3" json:"vx,omitempty"`
-	Vy float32 `protobuf:"fixed32,5,opt,name=vy,proto3" json:"vy,omitempty"`
-	Vz float32 `protobuf:"fixed32,6,opt,name=vz,proto3" json:"vz,omitempty"`
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // the admin
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	UserName     string `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	Amount       int32  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason       string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"` // admin_grant or season_reward
 }
 
-func (x *ObjectState) Reset() {
-	*x = ObjectState{}
+func (x *GrantCashReq) Reset() {
+	*x = GrantCashReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1114,13 +1422,13 @@ func (x *ObjectState) Reset() {
 	}
 }
 
-func (x *ObjectState) String() string {
+func (x *GrantCashReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ObjectState) ProtoMessage() {}
+func (*GrantCashReq) ProtoMessage() {}
 
-func (x *ObjectState) ProtoReflect() protoreflect.Message {
+func (x *GrantCashReq) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1132,66 +1440,56 @@ func (x *ObjectState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ObjectState.ProtoReflect.Descriptor instead.
-func (*ObjectState) Descriptor() ([]byte, []int) {
+// Deprecated: Use GrantCashReq.ProtoReflect.Descriptor instead.
+func (*GrantCashReq) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ObjectState) GetX() float32 {
-	if x != nil {
-		return x.X
-	}
-	return 0
-}
-
-func (x *ObjectState) GetY() float32 {
+func (x *GrantCashReq) GetUuid() string {
 	if x != nil {
-		return x.Y
+		return x.Uuid
 	}
-	return 0
+	return ""
 }
 
-func (x *ObjectState) GetZ() float32 {
+func (x *GrantCashReq) GetSessionToken() string {
 	if x != nil {
-		return x.Z
+		return x.SessionToken
 	}
-	return 0
+	return ""
 }
 
-func (x *ObjectState) GetVx() float32 {
+func (x *GrantCashReq) GetUserName() string {
 	if x != nil {
-		return x.Vx
+		return x.UserName
 	}
-	return 0
+	return ""
 }
 
-func (x *ObjectState) GetVy() float32 {
+func (x *GrantCashReq) GetAmount() int32 {
 	if x != nil {
-		return x.Vy
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *ObjectState) GetVz() float32 {
+func (x *GrantCashReq) GetReason() string {
 	if x != nil {
-		return x.Vz
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type Direction struct {
+type CashBalance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Input:
-	//	*Direction_KeyboardInput
-	//	*Direction_MouseInput
-	Input isDirection_Input `protobuf_oneof:"input"`
+	Cash int32 `protobuf:"varint,1,opt,name=cash,proto3" json:"cash,omitempty"`
 }
 
-func (x *Direction) Reset() {
-	*x = Direction{}
+func (x *CashBalance) Reset() {
+	*x = CashBalance{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1199,13 +1497,13 @@ func (x *Direction) Reset() {
 	}
 }
 
-func (x *Direction) String() string {
+func (x *CashBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Direction) ProtoMessage() {}
+func (*CashBalance) ProtoMessage() {}
 
-func (x *Direction) ProtoReflect() protoreflect.Message {
+func (x *CashBalance) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1217,61 +1515,28 @@ func (x *Direction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Direction.ProtoReflect.Descriptor instead.
-func (*Direction) Descriptor() ([]byte, []int) {
+// Deprecated: Use CashBalance.ProtoReflect.Descriptor instead.
+func (*CashBalance) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{18}
 }
 
-func (m *Direction) GetInput() isDirection_Input {
-	if m != nil {
-		return m.Input
-	}
-	return nil
-}
-
-func (x *Direction) GetKeyboardInput() *KeyboardInput {
-	if x, ok := x.GetInput().(*Direction_KeyboardInput); ok {
-		return x.KeyboardInput
-	}
-	return nil
-}
-
-func (x *Direction) GetMouseInput() *MouseInput {
-	if x, ok := x.GetInput().(*Direction_MouseInput); ok {
-		return x.MouseInput
+func (x *CashBalance) GetCash() int32 {
+	if x != nil {
+		return x.Cash
 	}
-	return nil
-}
-
-type isDirection_Input interface {
-	isDirection_Input()
-}
-
-type Direction_KeyboardInput struct {
-	KeyboardInput *KeyboardInput `protobuf:"bytes,1,opt,name=keyboardInput,proto3,oneof"`
-}
-
-type Direction_MouseInput struct {
-	MouseInput *MouseInput `protobuf:"bytes,2,opt,name=mouseInput,proto3,oneof"`
+	return 0
 }
 
-func (*Direction_KeyboardInput) isDirection_Input() {}
-
-func (*Direction_MouseInput) isDirection_Input() {}
-
-type KeyboardInput struct {
+type RankingList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UP    bool `protobuf:"varint,1,opt,name=UP,proto3" json:"UP,omitempty"`
-	DOWN  bool `protobuf:"varint,2,opt,name=DOWN,proto3" json:"DOWN,omitempty"`
-	LEFT  bool `protobuf:"varint,3,opt,name=LEFT,proto3" json:"LEFT,omitempty"`
-	RIGHT bool `protobuf:"varint,4,opt,name=RIGHT,proto3" json:"RIGHT,omitempty"`
+	RankingList []*PlayerRank `protobuf:"bytes,1,rep,name=rankingList,proto3" json:"rankingList,omitempty"`
 }
 
-func (x *KeyboardInput) Reset() {
-	*x = KeyboardInput{}
+func (x *RankingList) Reset() {
+	*x = RankingList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1279,13 +1544,13 @@ func (x *KeyboardInput) Reset() {
 	}
 }
 
-func (x *KeyboardInput) String() string {
+func (x *RankingList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KeyboardInput) ProtoMessage() {}
+func (*RankingList) ProtoMessage() {}
 
-func (x *KeyboardInput) ProtoReflect() protoreflect.Message {
+func (x *RankingList) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1297,65 +1562,43 @@ func (x *KeyboardInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KeyboardInput.ProtoReflect.Descriptor instead.
-func (*KeyboardInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use RankingList.ProtoReflect.Descriptor instead.
+func (*RankingList) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *KeyboardInput) GetUP() bool {
+func (x *RankingList) GetRankingList() []*PlayerRank {
 	if x != nil {
-		return x.UP
+		return x.RankingList
 	}
-	return false
+	return nil
 }
 
-func (x *KeyboardInput) GetDOWN() bool {
-	if x != nil {
-		return x.DOWN
-	}
-	return false
-}
+type PlayerRank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *KeyboardInput) GetLEFT() bool {
-	if x != nil {
-		return x.LEFT
-	}
-	return false
+	PlayerName string `protobuf:"bytes,1,opt,name=playerName,proto3" json:"playerName,omitempty"`
+	RankScore  int32  `protobuf:"varint,2,opt,name=rankScore,proto3" json:"rankScore,omitempty"`
 }
 
-func (x *KeyboardInput) GetRIGHT() bool {
-	if x != nil {
-		return x.RIGHT
+func (x *PlayerRank) Reset() {
+	*x = PlayerRank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-type MouseInput struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	X         float32 `protobuf:"fixed32,1,opt,name=X,proto3" json:"X,omitempty"`
-	Y         float32 `protobuf:"fixed32,2,opt,name=Y,proto3" json:"Y,omitempty"`
-	IsClicked bool    `protobuf:"varint,3,opt,name=isClicked,proto3" json:"isClicked,omitempty"`
-}
-
-func (x *MouseInput) Reset() {
-	*x = MouseInput{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_airHockey_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *MouseInput) String() string {
+func (x *PlayerRank) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MouseInput) ProtoMessage() {}
+func (*PlayerRank) ProtoMessage() {}
 
-func (x *MouseInput) ProtoReflect() protoreflect.Message {
+func (x *PlayerRank) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1367,42 +1610,41 @@ func (x *MouseInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MouseInput.ProtoReflect.Descriptor instead.
-func (*MouseInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerRank.ProtoReflect.Descriptor instead.
+func (*PlayerRank) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MouseInput) GetX() float32 {
+func (x *PlayerRank) GetPlayerName() string {
 	if x != nil {
-		return x.X
+		return x.PlayerName
 	}
-	return 0
+	return ""
 }
 
-func (x *MouseInput) GetY() float32 {
+func (x *PlayerRank) GetRankScore() int32 {
 	if x != nil {
-		return x.Y
+		return x.RankScore
 	}
 	return 0
 }
 
-func (x *MouseInput) GetIsClicked() bool {
-	if x != nil {
-		return x.IsClicked
-	}
-	return false
-}
-
-type NewPlayerName struct {
+type Record struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RecordTime   string   `protobuf:"bytes,1,opt,name=recordTime,proto3" json:"recordTime,omitempty"`
+	Team1        []string `protobuf:"bytes,2,rep,name=team1,proto3" json:"team1,omitempty"` // ignored, teams and score are the ones the server tracked
+	Team2        []string `protobuf:"bytes,3,rep,name=team2,proto3" json:"team2,omitempty"`
+	MatchScore   []int32  `protobuf:"varint,4,rep,packed,name=matchScore,proto3" json:"matchScore,omitempty"`
+	Uuid         string   `protobuf:"bytes,5,opt,name=uuid,proto3" json:"uuid,omitempty"` // the host of the room
+	SessionToken string   `protobuf:"bytes,6,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	RoomID       string   `protobuf:"bytes,7,opt,name=roomID,proto3" json:"roomID,omitempty"` // its match must have ended, stored once and before the host leaves
 }
 
-func (x *NewPlayerName) Reset() {
-	*x = NewPlayerName{}
+func (x *Record) Reset() {
+	*x = Record{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1410,13 +1652,13 @@ func (x *NewPlayerName) Reset() {
 	}
 }
 
-func (x *NewPlayerName) String() string {
+func (x *Record) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewPlayerName) ProtoMessage() {}
+func (*Record) ProtoMessage() {}
 
-func (x *NewPlayerName) ProtoReflect() protoreflect.Message {
+func (x *Record) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1428,29 +1670,70 @@ func (x *NewPlayerName) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewPlayerName.ProtoReflect.Descriptor instead.
-func (*NewPlayerName) Descriptor() ([]byte, []int) {
+// Deprecated: Use Record.ProtoReflect.Descriptor instead.
+func (*Record) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *NewPlayerName) GetName() string {
+func (x *Record) GetRecordTime() string {
 	if x != nil {
-		return x.Name
+		return x.RecordTime
 	}
 	return ""
 }
 
-type PlayerInfo struct {
+func (x *Record) GetTeam1() []string {
+	if x != nil {
+		return x.Team1
+	}
+	return nil
+}
+
+func (x *Record) GetTeam2() []string {
+	if x != nil {
+		return x.Team2
+	}
+	return nil
+}
+
+func (x *Record) GetMatchScore() []int32 {
+	if x != nil {
+		return x.MatchScore
+	}
+	return nil
+}
+
+func (x *Record) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Record) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *Record) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+type RecordID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 }
 
-func (x *PlayerInfo) Reset() {
-	*x = PlayerInfo{}
+func (x *RecordID) Reset() {
+	*x = RecordID{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1458,13 +1741,13 @@ func (x *PlayerInfo) Reset() {
 	}
 }
 
-func (x *PlayerInfo) String() string {
+func (x *RecordID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerInfo) ProtoMessage() {}
+func (*RecordID) ProtoMessage() {}
 
-func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
+func (x *RecordID) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1476,38 +1759,29 @@ func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerInfo.ProtoReflect.Descriptor instead.
-func (*PlayerInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecordID.ProtoReflect.Descriptor instead.
+func (*RecordID) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *PlayerInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *PlayerInfo) GetUuid() string {
+func (x *RecordID) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-type LoginPlayerInfo struct {
+type ChangePasswordReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Cash int32  `protobuf:"varint,3,opt,name=cash,proto3" json:"cash,omitempty"`
-	Rank int32  `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	AccountInfo *Account `protobuf:"bytes,1,opt,name=accountInfo,proto3" json:"accountInfo,omitempty"` // current user name and password
+	NewPassword string   `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
 }
 
-func (x *LoginPlayerInfo) Reset() {
-	*x = LoginPlayerInfo{}
+func (x *ChangePasswordReq) Reset() {
+	*x = ChangePasswordReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1515,13 +1789,13 @@ func (x *LoginPlayerInfo) Reset() {
 	}
 }
 
-func (x *LoginPlayerInfo) String() string {
+func (x *ChangePasswordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginPlayerInfo) ProtoMessage() {}
+func (*ChangePasswordReq) ProtoMessage() {}
 
-func (x *LoginPlayerInfo) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1533,49 +1807,36 @@ func (x *LoginPlayerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginPlayerInfo.ProtoReflect.Descriptor instead.
-func (*LoginPlayerInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
+func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *LoginPlayerInfo) GetName() string {
+func (x *ChangePasswordReq) GetAccountInfo() *Account {
 	if x != nil {
-		return x.Name
+		return x.AccountInfo
 	}
-	return ""
+	return nil
 }
 
-func (x *LoginPlayerInfo) GetUuid() string {
+func (x *ChangePasswordReq) GetNewPassword() string {
 	if x != nil {
-		return x.Uuid
+		return x.NewPassword
 	}
 	return ""
 }
 
-func (x *LoginPlayerInfo) GetCash() int32 {
-	if x != nil {
-		return x.Cash
-	}
-	return 0
-}
-
-func (x *LoginPlayerInfo) GetRank() int32 {
-	if x != nil {
-		return x.Rank
-	}
-	return 0
-}
-
-type PlayerID struct {
+type ChangeEmailReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID int32 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	AccountInfo *Account `protobuf:"bytes,1,opt,name=accountInfo,proto3" json:"accountInfo,omitempty"` // current user name and password
+	Email       string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`             // empty removes the email
 }
 
-func (x *PlayerID) Reset() {
-	*x = PlayerID{}
+func (x *ChangeEmailReq) Reset() {
+	*x = ChangeEmailReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1583,13 +1844,13 @@ func (x *PlayerID) Reset() {
 	}
 }
 
-func (x *PlayerID) String() string {
+func (x *ChangeEmailReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerID) ProtoMessage() {}
+func (*ChangeEmailReq) ProtoMessage() {}
 
-func (x *PlayerID) ProtoReflect() protoreflect.Message {
+func (x *ChangeEmailReq) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1601,28 +1862,37 @@ func (x *PlayerID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerID.ProtoReflect.Descriptor instead.
-func (*PlayerID) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeEmailReq.ProtoReflect.Descriptor instead.
+func (*ChangeEmailReq) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *PlayerID) GetID() int32 {
+func (x *ChangeEmailReq) GetAccountInfo() *Account {
 	if x != nil {
-		return x.ID
+		return x.AccountInfo
 	}
-	return 0
+	return nil
 }
 
-type PlayerList struct {
+func (x *ChangeEmailReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type NewAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Players []string `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AccountInfo *Account `protobuf:"bytes,2,opt,name=accountInfo,proto3" json:"accountInfo,omitempty"`
+	Email       string   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"` // optional, needed for password reset
 }
 
-func (x *PlayerList) Reset() {
-	*x = PlayerList{}
+func (x *NewAccountReq) Reset() {
+	*x = NewAccountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1630,13 +1900,13 @@ func (x *PlayerList) Reset() {
 	}
 }
 
-func (x *PlayerList) String() string {
+func (x *NewAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerList) ProtoMessage() {}
+func (*NewAccountReq) ProtoMessage() {}
 
-func (x *PlayerList) ProtoReflect() protoreflect.Message {
+func (x *NewAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1648,26 +1918,46 @@ func (x *PlayerList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerList.ProtoReflect.Descriptor instead.
-func (*PlayerList) Descriptor() ([]byte, []int) {
+// Deprecated: Use NewAccountReq.ProtoReflect.Descriptor instead.
+func (*NewAccountReq) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *PlayerList) GetPlayers() []string {
+func (x *NewAccountReq) GetName() string {
 	if x != nil {
-		return x.Players
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NewAccountReq) GetAccountInfo() *Account {
+	if x != nil {
+		return x.AccountInfo
 	}
 	return nil
 }
 
-type Empty struct {
+func (x *NewAccountReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ClaimGuestReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Uuid         string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // the guest player ID
+	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AccountInfo  *Account `protobuf:"bytes,3,opt,name=accountInfo,proto3" json:"accountInfo,omitempty"`
+	Email        string   `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	SessionToken string   `protobuf:"bytes,5,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"` // the guest's current session
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *ClaimGuestReq) Reset() {
+	*x = ClaimGuestReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pb_airHockey_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1675,13 +1965,13 @@ func (x *Empty) Reset() {
 	}
 }
 
-func (x *Empty) String() string {
+func (x *ClaimGuestReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*ClaimGuestReq) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *ClaimGuestReq) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_airHockey_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1693,1069 +1983,7934 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClaimGuestReq.ProtoReflect.Descriptor instead.
+func (*ClaimGuestReq) Descriptor() ([]byte, []int) {
 	return file_pb_airHockey_proto_rawDescGZIP(), []int{26}
 }
 
-var File_pb_airHockey_proto protoreflect.FileDescriptor
+func (x *ClaimGuestReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
 
-var file_pb_airHockey_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x62, 0x2f, 0x61, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x22,
-	0x5c, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x4e, 0x65, 0x77, 0x53, 0x6b, 0x69, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x6b, 0x69,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x53, 0x6b, 0x69,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6b, 0x69, 0x6e, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x6b, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x72, 0x0a,
-	0x08, 0x53, 0x6b, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x63,
-	0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x70,
-	0x75, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x72,
-	0x69, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05,
-	0x52, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x44, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0x71, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x6b, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x73, 0x68,
-	0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
-	0x43, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x43, 0x61, 0x73, 0x68,
-	0x12, 0x12, 0x0a, 0x04, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x52, 0x61, 0x6e, 0x6b, 0x22, 0x46, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
-	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x52,
-	0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x0a,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61,
-	0x6e, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72,
-	0x61, 0x6e, 0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x74, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x31, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d,
-	0x32, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x32, 0x12, 0x1e,
-	0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x1e,
-	0x0a, 0x08, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x59,
-	0x0a, 0x0d, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
-	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
-	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x41, 0x0a, 0x07, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x87, 0x02, 0x0a,
-	0x0b, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0b,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
-	0x6b, 0x65, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
-	0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48,
-	0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
-	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0x9b, 0x01, 0x0a, 0x0b, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x69, 0x72,
-	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x70,
-	0x75, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48,
-	0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x04, 0x70, 0x75, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x69,
-	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x73, 0x50, 0x6c, 0x61, 0x79,
-	0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x61, 0x6d,
-	0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65,
-	0x61, 0x6d, 0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x61, 0x6d,
-	0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65,
-	0x61, 0x6d, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0x5d, 0x0a, 0x0c, 0x4c,
-	0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52,
-	0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x6f, 0x6f,
-	0x6d, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
-	0x6b, 0x65, 0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x5c, 0x0a, 0x0b, 0x4a, 0x6f,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6f,
-	0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49,
-	0x44, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
-	0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x6b, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x47,
-	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x4f, 0x66, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12,
-	0x20, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x24, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12,
-	0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x44, 0x22, 0x67, 0x0a, 0x0b, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x01, 0x7a, 0x12, 0x0e, 0x0a, 0x02, 0x76, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x02, 0x76, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x76, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x02, 0x76, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x76, 0x7a, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x02, 0x76, 0x7a, 0x22, 0x8f, 0x01, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x41, 0x69, 0x72, 0x48,
-	0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
-	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4d, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48,
-	0x00, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x07, 0x0a,
-	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x5d, 0x0a, 0x0d, 0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x55, 0x50, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x02, 0x55, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x12, 0x12, 0x0a, 0x04, 0x4c,
-	0x45, 0x46, 0x54, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4c, 0x45, 0x46, 0x54, 0x12,
-	0x14, 0x0a, 0x05, 0x52, 0x49, 0x47, 0x48, 0x54, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x52, 0x49, 0x47, 0x48, 0x54, 0x22, 0x46, 0x0a, 0x0a, 0x4d, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x58, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01,
-	0x58, 0x12, 0x0c, 0x0a, 0x01, 0x59, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x59, 0x12,
-	0x1c, 0x0a, 0x09, 0x69, 0x73, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x23, 0x0a,
-	0x0d, 0x4e, 0x65, 0x77, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
-	0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x63, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x1a, 0x0a, 0x08, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22,
-	0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x32, 0x9b, 0x06, 0x0a, 0x10, 0x41, 0x69, 0x72,
-	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a,
-	0x0a, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x41, 0x69,
-	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
-	0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x05, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x12, 0x12, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x1a, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
-	0x65, 0x79, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x52, 0x6f, 0x6f, 0x6d, 0x12,
-	0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4e, 0x65, 0x77, 0x47,
-	0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x11, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
-	0x6b, 0x65, 0x79, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08,
-	0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
-	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x11, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x6f, 0x6f,
-	0x6d, 0x49, 0x44, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
-	0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x41, 0x69,
-	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x09, 0x4e, 0x65, 0x77,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x11, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
-	0x65, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x1a, 0x13, 0x2e, 0x41, 0x69, 0x72, 0x48,
-	0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x22, 0x00,
-	0x12, 0x3f, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x41, 0x6e,
-	0x64, 0x43, 0x61, 0x73, 0x68, 0x12, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
-	0x79, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x73, 0x68, 0x1a, 0x10, 0x2e,
-	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x37, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x53, 0x6b, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x41,
-	0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x4e, 0x65, 0x77, 0x53,
-	0x6b, 0x69, 0x6e, 0x1a, 0x13, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
-	0x53, 0x6b, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x11, 0x2e, 0x41, 0x69,
-	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x1a, 0x15,
-	0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65,
-	0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x17, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
-	0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x39, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12,
-	0x17, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
-	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
-	0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x61,
-	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x53, 0x6b, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x13, 0x2e, 0x41, 0x69, 0x72,
-	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x1a,
-	0x13, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x6b, 0x69, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x42, 0x05, 0x5a, 0x03, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ClaimGuestReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-var (
-	file_pb_airHockey_proto_rawDescOnce sync.Once
-	file_pb_airHockey_proto_rawDescData = file_pb_airHockey_proto_rawDesc
-)
+func (x *ClaimGuestReq) GetAccountInfo() *Account {
+	if x != nil {
+		return x.AccountInfo
+	}
+	return nil
+}
 
-func file_pb_airHockey_proto_rawDescGZIP() []byte {
-	file_pb_airHockey_proto_rawDescOnce.Do(func() {
-		file_pb_airHockey_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_airHockey_proto_rawDescData)
-	})
-	return file_pb_airHockey_proto_rawDescData
+func (x *ClaimGuestReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-var file_pb_airHockey_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_pb_airHockey_proto_goTypes = []interface{}{
-	(*AddNewSkin)(nil),      // 0: AirHockey.AddNewSkin
-	(*SkinList)(nil),        // 1: AirHockey.SkinList
-	(*RankAndCash)(nil),     // 2: AirHockey.RankAndCash
-	(*RankingList)(nil),     // 3: AirHockey.RankingList
-	(*PlayerRank)(nil),      // 4: AirHockey.PlayerRank
-	(*Record)(nil),          // 5: AirHockey.Record
-	(*RecordID)(nil),        // 6: AirHockey.RecordID
-	(*NewAccountReq)(nil),   // 7: AirHockey.NewAccountReq
-	(*Account)(nil),         // 8: AirHockey.Account
-	(*GameMessage)(nil),     // 9: AirHockey.GameMessage
-	(*PlayerInput)(nil),     // 10: AirHockey.PlayerInput
-	(*EntityState)(nil),     // 11: AirHockey.EntityState
-	(*GameState)(nil),       // 12: AirHockey.GameState
-	(*LeaveRequest)(nil),    // 13: AirHockey.LeaveRequest
-	(*JoinRequest)(nil),     // 14: AirHockey.JoinRequest
-	(*NewGameInfo)(nil),     // 15: AirHockey.NewGameInfo
-	(*RoomID)(nil),          // 16: AirHockey.RoomID
-	(*ObjectState)(nil),     // 17: AirHockey.ObjectState
-	(*Direction)(nil),       // 18: AirHockey.Direction
-	(*KeyboardInput)(nil),   // 19: AirHockey.KeyboardInput
-	(*MouseInput)(nil),      // 20: AirHockey.MouseInput
-	(*NewPlayerName)(nil),   // 21: AirHockey.NewPlayerName
-	(*PlayerInfo)(nil),      // 22: AirHockey.PlayerInfo
-	(*LoginPlayerInfo)(nil), // 23: AirHockey.LoginPlayerInfo
-	(*PlayerID)(nil),        // 24: AirHockey.PlayerID
-	(*PlayerList)(nil),      // 25: AirHockey.PlayerList
-	(*Empty)(nil),           // 26: AirHockey.Empty
+func (x *ClaimGuestReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
 }
-var file_pb_airHockey_proto_depIdxs = []int32{
-	4,  // 0: AirHockey.RankingList.rankingList:type_name -> AirHockey.PlayerRank
-	8,  // 1: AirHockey.NewAccountReq.accountInfo:type_name -> AirHockey.Account
-	10, // 2: AirHockey.GameMessage.playerInput:type_name -> AirHockey.PlayerInput
-	11, // 3: AirHockey.GameMessage.entityState:type_name -> AirHockey.EntityState
-	12, // 4: AirHockey.GameMessage.gameState:type_name -> AirHockey.GameState
-	26, // 5: AirHockey.GameMessage.empty:type_name -> AirHockey.Empty
-	18, // 6: AirHockey.PlayerInput.direction:type_name -> AirHockey.Direction
-	17, // 7: AirHockey.EntityState.players:type_name -> AirHockey.ObjectState
-	17, // 8: AirHockey.EntityState.puck:type_name -> AirHockey.ObjectState
-	22, // 9: AirHockey.LeaveRequest.playerInfo:type_name -> AirHockey.PlayerInfo
-	22, // 10: AirHockey.JoinRequest.playerInfo:type_name -> AirHockey.PlayerInfo
-	19, // 11: AirHockey.Direction.keyboardInput:type_name -> AirHockey.KeyboardInput
-	20, // 12: AirHockey.Direction.mouseInput:type_name -> AirHockey.MouseInput
-	7,  // 13: AirHockey.AirHockeyService.NewAccount:input_type -> AirHockey.NewAccountReq
-	8,  // 14: AirHockey.AirHockeyService.Login:input_type -> AirHockey.Account
-	15, // 15: AirHockey.AirHockeyService.NewRoom:input_type -> AirHockey.NewGameInfo
-	14, // 16: AirHockey.AirHockeyService.JoinRoom:input_type -> AirHockey.JoinRequest
-	9,  // 17: AirHockey.AirHockeyService.GameStream:input_type -> AirHockey.GameMessage
-	5,  // 18: AirHockey.AirHockeyService.NewRecord:input_type -> AirHockey.Record
-	2,  // 19: AirHockey.AirHockeyService.UpdateRankAndCash:input_type -> AirHockey.RankAndCash
-	0,  // 20: AirHockey.AirHockeyService.AddSkin:input_type -> AirHockey.AddNewSkin
-	16, // 21: AirHockey.AirHockeyService.GetPlayerList:input_type -> AirHockey.RoomID
-	13, // 22: AirHockey.AirHockeyService.LeaveRoom:input_type -> AirHockey.LeaveRequest
-	13, // 23: AirHockey.AirHockeyService.Disconnect:input_type -> AirHockey.LeaveRequest
-	26, // 24: AirHockey.AirHockeyService.GetGlobalRecord:input_type -> AirHockey.Empty
-	24, // 25: AirHockey.AirHockeyService.GetSkinList:input_type -> AirHockey.PlayerID
-	26, // 26: AirHockey.AirHockeyService.NewAccount:output_type -> AirHockey.Empty
-	23, // 27: AirHockey.AirHockeyService.Login:output_type -> AirHockey.LoginPlayerInfo
-	16, // 28: AirHockey.AirHockeyService.NewRoom:output_type -> AirHockey.RoomID
-	16, // 29: AirHockey.AirHockeyService.JoinRoom:output_type -> AirHockey.RoomID
-	9,  // 30: AirHockey.AirHockeyService.GameStream:output_type -> AirHockey.GameMessage
-	6,  // 31: AirHockey.AirHockeyService.NewRecord:output_type -> AirHockey.RecordID
-	26, // 32: AirHockey.AirHockeyService.UpdateRankAndCash:output_type -> AirHockey.Empty
-	1,  // 33: AirHockey.AirHockeyService.AddSkin:output_type -> AirHockey.SkinList
-	25, // 34: AirHockey.AirHockeyService.GetPlayerList:output_type -> AirHockey.PlayerList
-	26, // 35: AirHockey.AirHockeyService.LeaveRoom:output_type -> AirHockey.Empty
-	26, // 36: AirHockey.AirHockeyService.Disconnect:output_type -> AirHockey.Empty
-	3,  // 37: AirHockey.AirHockeyService.GetGlobalRecord:output_type -> AirHockey.RankingList
-	1,  // 38: AirHockey.AirHockeyService.GetSkinList:output_type -> AirHockey.SkinList
-	26, // [26:39] is the sub-list for method output_type
-	13, // [13:26] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+
+type PasswordResetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 }
 
-func init() { file_pb_airHockey_proto_init() }
-func file_pb_airHockey_proto_init() {
-	if File_pb_airHockey_proto != nil {
-		return
+func (x *PasswordResetReq) Reset() {
+	*x = PasswordResetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pb_airHockey_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddNewSkin); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_airHockey_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SkinList); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_airHockey_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RankAndCash); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *PasswordResetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordResetReq) ProtoMessage() {}
+
+func (x *PasswordResetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RankingList); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordResetReq.ProtoReflect.Descriptor instead.
+func (*PasswordResetReq) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PasswordResetReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResetPasswordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+}
+
+func (x *ResetPasswordReq) Reset() {
+	*x = ResetPasswordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordReq) ProtoMessage() {}
+
+func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerRank); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordReq.ProtoReflect.Descriptor instead.
+func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ResetPasswordReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type Account struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName string `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Record); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Account) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Account) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type GameMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Action:
+	//	*GameMessage_PlayerInput
+	//	*GameMessage_EntityState
+	//	*GameMessage_GameState
+	//	*GameMessage_Empty
+	//	*GameMessage_SessionEvent
+	//	*GameMessage_Invitation
+	//	*GameMessage_InvitationAnswer
+	//	*GameMessage_ChatMessage
+	//	*GameMessage_Emote
+	//	*GameMessage_ModerationNotice
+	//	*GameMessage_AchievementUnlocked
+	//	*GameMessage_Gift
+	Action       isGameMessage_Action `protobuf_oneof:"action"`
+	Sender       string               `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	SessionToken string               `protobuf:"bytes,14,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"` // required on the first message of a GameStream
+}
+
+func (x *GameMessage) Reset() {
+	*x = GameMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMessage) ProtoMessage() {}
+
+func (x *GameMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecordID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_airHockey_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewAccountReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_airHockey_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Account); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_airHockey_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameMessage); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMessage.ProtoReflect.Descriptor instead.
+func (*GameMessage) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{30}
+}
+
+func (m *GameMessage) GetAction() isGameMessage_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *GameMessage) GetPlayerInput() *PlayerInput {
+	if x, ok := x.GetAction().(*GameMessage_PlayerInput); ok {
+		return x.PlayerInput
+	}
+	return nil
+}
+
+func (x *GameMessage) GetEntityState() *EntityState {
+	if x, ok := x.GetAction().(*GameMessage_EntityState); ok {
+		return x.EntityState
+	}
+	return nil
+}
+
+func (x *GameMessage) GetGameState() *GameState {
+	if x, ok := x.GetAction().(*GameMessage_GameState); ok {
+		return x.GameState
+	}
+	return nil
+}
+
+func (x *GameMessage) GetEmpty() *Empty {
+	if x, ok := x.GetAction().(*GameMessage_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *GameMessage) GetSessionEvent() *SessionEvent {
+	if x, ok := x.GetAction().(*GameMessage_SessionEvent); ok {
+		return x.SessionEvent
+	}
+	return nil
+}
+
+func (x *GameMessage) GetInvitation() *Invitation {
+	if x, ok := x.GetAction().(*GameMessage_Invitation); ok {
+		return x.Invitation
+	}
+	return nil
+}
+
+func (x *GameMessage) GetInvitationAnswer() *InvitationAnswer {
+	if x, ok := x.GetAction().(*GameMessage_InvitationAnswer); ok {
+		return x.InvitationAnswer
+	}
+	return nil
+}
+
+func (x *GameMessage) GetChatMessage() *ChatMessage {
+	if x, ok := x.GetAction().(*GameMessage_ChatMessage); ok {
+		return x.ChatMessage
+	}
+	return nil
+}
+
+func (x *GameMessage) GetEmote() *Emote {
+	if x, ok := x.GetAction().(*GameMessage_Emote); ok {
+		return x.Emote
+	}
+	return nil
+}
+
+func (x *GameMessage) GetModerationNotice() *ModerationNotice {
+	if x, ok := x.GetAction().(*GameMessage_ModerationNotice); ok {
+		return x.ModerationNotice
+	}
+	return nil
+}
+
+func (x *GameMessage) GetAchievementUnlocked() *Achievement {
+	if x, ok := x.GetAction().(*GameMessage_AchievementUnlocked); ok {
+		return x.AchievementUnlocked
+	}
+	return nil
+}
+
+func (x *GameMessage) GetGift() *Gift {
+	if x, ok := x.GetAction().(*GameMessage_Gift); ok {
+		return x.Gift
+	}
+	return nil
+}
+
+func (x *GameMessage) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *GameMessage) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type isGameMessage_Action interface {
+	isGameMessage_Action()
+}
+
+type GameMessage_PlayerInput struct {
+	PlayerInput *PlayerInput `protobuf:"bytes,1,opt,name=playerInput,proto3,oneof"`
+}
+
+type GameMessage_EntityState struct {
+	EntityState *EntityState `protobuf:"bytes,2,opt,name=entityState,proto3,oneof"`
+}
+
+type GameMessage_GameState struct {
+	GameState *GameState `protobuf:"bytes,3,opt,name=gameState,proto3,oneof"`
+}
+
+type GameMessage_Empty struct {
+	Empty *Empty `protobuf:"bytes,4,opt,name=empty,proto3,oneof"`
+}
+
+type GameMessage_SessionEvent struct {
+	SessionEvent *SessionEvent `protobuf:"bytes,6,opt,name=sessionEvent,proto3,oneof"` // server -> client only
+}
+
+type GameMessage_Invitation struct {
+	Invitation *Invitation `protobuf:"bytes,7,opt,name=invitation,proto3,oneof"` // server -> client only
+}
+
+type GameMessage_InvitationAnswer struct {
+	InvitationAnswer *InvitationAnswer `protobuf:"bytes,8,opt,name=invitationAnswer,proto3,oneof"` // server -> client only, sent to the host
+}
+
+type GameMessage_ChatMessage struct {
+	ChatMessage *ChatMessage `protobuf:"bytes,9,opt,name=chatMessage,proto3,oneof"`
+}
+
+type GameMessage_Emote struct {
+	Emote *Emote `protobuf:"bytes,10,opt,name=emote,proto3,oneof"` // quick-chat, owned like skins (SkinType 4)
+}
+
+type GameMessage_ModerationNotice struct {
+	ModerationNotice *ModerationNotice `protobuf:"bytes,11,opt,name=moderationNotice,proto3,oneof"` // server -> client only
+}
+
+type GameMessage_AchievementUnlocked struct {
+	AchievementUnlocked *Achievement `protobuf:"bytes,12,opt,name=achievementUnlocked,proto3,oneof"` // server -> client only
+}
+
+type GameMessage_Gift struct {
+	Gift *Gift `protobuf:"bytes,13,opt,name=gift,proto3,oneof"` // server -> client only, a friend sent a gift
+}
+
+func (*GameMessage_PlayerInput) isGameMessage_Action() {}
+
+func (*GameMessage_EntityState) isGameMessage_Action() {}
+
+func (*GameMessage_GameState) isGameMessage_Action() {}
+
+func (*GameMessage_Empty) isGameMessage_Action() {}
+
+func (*GameMessage_SessionEvent) isGameMessage_Action() {}
+
+func (*GameMessage_Invitation) isGameMessage_Action() {}
+
+func (*GameMessage_InvitationAnswer) isGameMessage_Action() {}
+
+func (*GameMessage_ChatMessage) isGameMessage_Action() {}
+
+func (*GameMessage_Emote) isGameMessage_Action() {}
+
+func (*GameMessage_ModerationNotice) isGameMessage_Action() {}
+
+func (*GameMessage_AchievementUnlocked) isGameMessage_Action() {}
+
+func (*GameMessage_Gift) isGameMessage_Action() {}
+
+type SessionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"` // e.g. the account logged in elsewhere
+}
+
+func (x *SessionEvent) Reset() {
+	*x = SessionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent) ProtoMessage() {}
+
+func (x *SessionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerInput); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent.ProtoReflect.Descriptor instead.
+func (*SessionEvent) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SessionEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type InviteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // the host
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	RoomID       string `protobuf:"bytes,3,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	UserName     string `protobuf:"bytes,4,opt,name=userName,proto3" json:"userName,omitempty"` // the invited player
+}
+
+func (x *InviteRequest) Reset() {
+	*x = InviteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteRequest) ProtoMessage() {}
+
+func (x *InviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityState); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_airHockey_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameState); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_airHockey_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeaveRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteRequest.ProtoReflect.Descriptor instead.
+func (*InviteRequest) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InviteRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *InviteRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *InviteRequest) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *InviteRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type Invitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationID string `protobuf:"bytes,1,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
+	RoomID       string `protobuf:"bytes,2,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	FromUserName string `protobuf:"bytes,3,opt,name=fromUserName,proto3" json:"fromUserName,omitempty"`
+	ExpiresAt    int64  `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"` // unix seconds
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Invitation) GetInvitationID() string {
+	if x != nil {
+		return x.InvitationID
+	}
+	return ""
+}
+
+func (x *Invitation) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *Invitation) GetFromUserName() string {
+	if x != nil {
+		return x.FromUserName
+	}
+	return ""
+}
+
+func (x *Invitation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type InvitationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // the invited player
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	InvitationID string `protobuf:"bytes,3,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
+	Accept       bool   `protobuf:"varint,4,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *InvitationResponse) Reset() {
+	*x = InvitationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationResponse) ProtoMessage() {}
+
+func (x *InvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewGameInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationResponse.ProtoReflect.Descriptor instead.
+func (*InvitationResponse) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InvitationResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetInvitationID() string {
+	if x != nil {
+		return x.InvitationID
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type InvitationAnswer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationID string `protobuf:"bytes,1,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
+	UserName     string `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	Accepted     bool   `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+}
+
+func (x *InvitationAnswer) Reset() {
+	*x = InvitationAnswer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvitationAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationAnswer) ProtoMessage() {}
+
+func (x *InvitationAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoomID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationAnswer.ProtoReflect.Descriptor instead.
+func (*InvitationAnswer) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InvitationAnswer) GetInvitationID() string {
+	if x != nil {
+		return x.InvitationID
+	}
+	return ""
+}
+
+func (x *InvitationAnswer) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *InvitationAnswer) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type ChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel      ChatChannel `protobuf:"varint,1,opt,name=channel,proto3,enum=AirHockey.ChatChannel" json:"channel,omitempty"`
+	RoomID       string      `protobuf:"bytes,2,opt,name=roomID,proto3" json:"roomID,omitempty"` // for ROOM messages
+	Text         string      `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	FromUserName string      `protobuf:"bytes,4,opt,name=fromUserName,proto3" json:"fromUserName,omitempty"` // set by the server
+}
+
+func (x *ChatMessage) Reset() {
+	*x = ChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessage) ProtoMessage() {}
+
+func (x *ChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObjectState); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_airHockey_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Direction); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_airHockey_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeyboardInput); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pb_airHockey_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MouseInput); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessage.ProtoReflect.Descriptor instead.
+func (*ChatMessage) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ChatMessage) GetChannel() ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ChatChannel_ROOM
+}
+
+func (x *ChatMessage) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetFromUserName() string {
+	if x != nil {
+		return x.FromUserName
+	}
+	return ""
+}
+
+type Emote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmoteID      int32  `protobuf:"varint,1,opt,name=emoteID,proto3" json:"emoteID,omitempty"`
+	RoomID       string `protobuf:"bytes,2,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	FromUserName string `protobuf:"bytes,3,opt,name=fromUserName,proto3" json:"fromUserName,omitempty"` // set by the server
+}
+
+func (x *Emote) Reset() {
+	*x = Emote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Emote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Emote) ProtoMessage() {}
+
+func (x *Emote) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewPlayerName); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Emote.ProtoReflect.Descriptor instead.
+func (*Emote) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Emote) GetEmoteID() int32 {
+	if x != nil {
+		return x.EmoteID
+	}
+	return 0
+}
+
+func (x *Emote) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *Emote) GetFromUserName() string {
+	if x != nil {
+		return x.FromUserName
+	}
+	return ""
+}
+
+type ReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string       `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // the reporter
+	SessionToken string       `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	UserName     string       `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"` // the reported player
+	Reason       ReportReason `protobuf:"varint,4,opt,name=reason,proto3,enum=AirHockey.ReportReason" json:"reason,omitempty"`
+	RoomID       string       `protobuf:"bytes,5,opt,name=roomID,proto3" json:"roomID,omitempty"` // where it happened, if in a room
+	Details      string       `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *ReportRequest) Reset() {
+	*x = ReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportRequest) ProtoMessage() {}
+
+func (x *ReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
+func (*ReportRequest) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReportRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_OTHER
+}
+
+func (x *ReportRequest) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *ReportRequest) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type ReportID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportID string `protobuf:"bytes,1,opt,name=reportID,proto3" json:"reportID,omitempty"`
+}
+
+func (x *ReportID) Reset() {
+	*x = ReportID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportID) ProtoMessage() {}
+
+func (x *ReportID) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginPlayerInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportID.ProtoReflect.Descriptor instead.
+func (*ReportID) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReportID) GetReportID() string {
+	if x != nil {
+		return x.ReportID
+	}
+	return ""
+}
+
+type ListReportsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid            string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken    string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	IncludeResolved bool   `protobuf:"varint,3,opt,name=includeResolved,proto3" json:"includeResolved,omitempty"`
+}
+
+func (x *ListReportsReq) Reset() {
+	*x = ListReportsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReportsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsReq) ProtoMessage() {}
+
+func (x *ListReportsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerID); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsReq.ProtoReflect.Descriptor instead.
+func (*ListReportsReq) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListReportsReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ListReportsReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ListReportsReq) GetIncludeResolved() bool {
+	if x != nil {
+		return x.IncludeResolved
+	}
+	return false
+}
+
+type Report struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportID         string       `protobuf:"bytes,1,opt,name=reportID,proto3" json:"reportID,omitempty"`
+	ReporterUserName string       `protobuf:"bytes,2,opt,name=reporterUserName,proto3" json:"reporterUserName,omitempty"`
+	UserName         string       `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	Reason           ReportReason `protobuf:"varint,4,opt,name=reason,proto3,enum=AirHockey.ReportReason" json:"reason,omitempty"`
+	RoomID           string       `protobuf:"bytes,5,opt,name=roomID,proto3" json:"roomID,omitempty"`
+	Details          string       `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+	RecentRecords    []*Record    `protobuf:"bytes,7,rep,name=recentRecords,proto3" json:"recentRecords,omitempty"` // the reported player's last matches
+	CreatedAt        int64        `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`        // unix seconds
+	Resolved         bool         `protobuf:"varint,9,opt,name=resolved,proto3" json:"resolved,omitempty"`
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerList); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Report) GetReportID() string {
+	if x != nil {
+		return x.ReportID
+	}
+	return ""
+}
+
+func (x *Report) GetReporterUserName() string {
+	if x != nil {
+		return x.ReporterUserName
+	}
+	return ""
+}
+
+func (x *Report) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Report) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_OTHER
+}
+
+func (x *Report) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *Report) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *Report) GetRecentRecords() []*Record {
+	if x != nil {
+		return x.RecentRecords
+	}
+	return nil
+}
+
+func (x *Report) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Report) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+type ReportList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reports []*Report `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (x *ReportList) Reset() {
+	*x = ReportList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportList) ProtoMessage() {}
+
+func (x *ReportList) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_airHockey_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Empty); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportList.ProtoReflect.Descriptor instead.
+func (*ReportList) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReportList) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type ModerationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid            string           `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"` // the admin
+	SessionToken    string           `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	UserName        string           `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"` // the player to act on
+	Action          ModerationAction `protobuf:"varint,4,opt,name=action,proto3,enum=AirHockey.ModerationAction" json:"action,omitempty"`
+	DurationMinutes int32            `protobuf:"varint,5,opt,name=durationMinutes,proto3" json:"durationMinutes,omitempty"` // MUTE_CHAT and TEMP_BAN
+	Reason          string           `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReportID        string           `protobuf:"bytes,7,opt,name=reportID,proto3" json:"reportID,omitempty"` // optional, marked resolved
+}
+
+func (x *ModerationRequest) Reset() {
+	*x = ModerationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationRequest) ProtoMessage() {}
+
+func (x *ModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationRequest.ProtoReflect.Descriptor instead.
+func (*ModerationRequest) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ModerationRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ModerationRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ModerationRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ModerationRequest) GetAction() ModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationAction_WARN
+}
+
+func (x *ModerationRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *ModerationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationRequest) GetReportID() string {
+	if x != nil {
+		return x.ReportID
+	}
+	return ""
+}
+
+type Achievement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RewardCash     int32  `protobuf:"varint,4,opt,name=rewardCash,proto3" json:"rewardCash,omitempty"`
+	RewardSkinType int32  `protobuf:"varint,5,opt,name=rewardSkinType,proto3" json:"rewardSkinType,omitempty"` // 0 when there is no skin reward
+	RewardSkinID   int32  `protobuf:"varint,6,opt,name=rewardSkinID,proto3" json:"rewardSkinID,omitempty"`
+	Unlocked       bool   `protobuf:"varint,7,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	UnlockedAt     int64  `protobuf:"varint,8,opt,name=unlockedAt,proto3" json:"unlockedAt,omitempty"` // unix seconds
+}
+
+func (x *Achievement) Reset() {
+	*x = Achievement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Achievement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Achievement) ProtoMessage() {}
+
+func (x *Achievement) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Achievement.ProtoReflect.Descriptor instead.
+func (*Achievement) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Achievement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Achievement) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Achievement) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Achievement) GetRewardCash() int32 {
+	if x != nil {
+		return x.RewardCash
+	}
+	return 0
+}
+
+func (x *Achievement) GetRewardSkinType() int32 {
+	if x != nil {
+		return x.RewardSkinType
+	}
+	return 0
+}
+
+func (x *Achievement) GetRewardSkinID() int32 {
+	if x != nil {
+		return x.RewardSkinID
+	}
+	return 0
+}
+
+func (x *Achievement) GetUnlocked() bool {
+	if x != nil {
+		return x.Unlocked
+	}
+	return false
+}
+
+func (x *Achievement) GetUnlockedAt() int64 {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return 0
+}
+
+type AchievementsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+}
+
+func (x *AchievementsReq) Reset() {
+	*x = AchievementsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AchievementsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementsReq) ProtoMessage() {}
+
+func (x *AchievementsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementsReq.ProtoReflect.Descriptor instead.
+func (*AchievementsReq) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AchievementsReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *AchievementsReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type AchievementList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Achievements []*Achievement `protobuf:"bytes,1,rep,name=achievements,proto3" json:"achievements,omitempty"`
+}
+
+func (x *AchievementList) Reset() {
+	*x = AchievementList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AchievementList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AchievementList) ProtoMessage() {}
+
+func (x *AchievementList) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AchievementList.ProtoReflect.Descriptor instead.
+func (*AchievementList) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AchievementList) GetAchievements() []*Achievement {
+	if x != nil {
+		return x.Achievements
+	}
+	return nil
+}
+
+type Mission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Period      string `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"` // daily or weekly
+	Target      int32  `protobuf:"varint,5,opt,name=target,proto3" json:"target,omitempty"`
+	Progress    int32  `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	RewardCash  int32  `protobuf:"varint,7,opt,name=rewardCash,proto3" json:"rewardCash,omitempty"`
+	Claimed     bool   `protobuf:"varint,8,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	ResetsAt    int64  `protobuf:"varint,9,opt,name=resetsAt,proto3" json:"resetsAt,omitempty"` // unix seconds
+}
+
+func (x *Mission) Reset() {
+	*x = Mission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mission) ProtoMessage() {}
+
+func (x *Mission) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mission.ProtoReflect.Descriptor instead.
+func (*Mission) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Mission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Mission) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Mission) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Mission) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Mission) GetTarget() int32 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *Mission) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Mission) GetRewardCash() int32 {
+	if x != nil {
+		return x.RewardCash
+	}
+	return 0
+}
+
+func (x *Mission) GetClaimed() bool {
+	if x != nil {
+		return x.Claimed
+	}
+	return false
+}
+
+func (x *Mission) GetResetsAt() int64 {
+	if x != nil {
+		return x.ResetsAt
+	}
+	return 0
+}
+
+type MissionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+}
+
+func (x *MissionsReq) Reset() {
+	*x = MissionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissionsReq) ProtoMessage() {}
+
+func (x *MissionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissionsReq.ProtoReflect.Descriptor instead.
+func (*MissionsReq) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MissionsReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *MissionsReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type MissionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Missions []*Mission `protobuf:"bytes,1,rep,name=missions,proto3" json:"missions,omitempty"`
+}
+
+func (x *MissionList) Reset() {
+	*x = MissionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissionList) ProtoMessage() {}
+
+func (x *MissionList) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissionList.ProtoReflect.Descriptor instead.
+func (*MissionList) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MissionList) GetMissions() []*Mission {
+	if x != nil {
+		return x.Missions
+	}
+	return nil
+}
+
+type ClaimMissionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	MissionID    string `protobuf:"bytes,3,opt,name=missionID,proto3" json:"missionID,omitempty"`
+}
+
+func (x *ClaimMissionReq) Reset() {
+	*x = ClaimMissionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimMissionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimMissionReq) ProtoMessage() {}
+
+func (x *ClaimMissionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimMissionReq.ProtoReflect.Descriptor instead.
+func (*ClaimMissionReq) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ClaimMissionReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ClaimMissionReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *ClaimMissionReq) GetMissionID() string {
+	if x != nil {
+		return x.MissionID
+	}
+	return ""
+}
+
+type SendGiftReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	UserName     string `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`  // the friend receiving the gift
+	SkinType     int32  `protobuf:"varint,4,opt,name=skinType,proto3" json:"skinType,omitempty"` // a catalog skin bought at its shop price, or
+	SkinID       int32  `protobuf:"varint,5,opt,name=skinID,proto3" json:"skinID,omitempty"`
+	Cash         int32  `protobuf:"varint,6,opt,name=cash,proto3" json:"cash,omitempty"` // cash sent as is, set only one of the two
+}
+
+func (x *SendGiftReq) Reset() {
+	*x = SendGiftReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendGiftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendGiftReq) ProtoMessage() {}
+
+func (x *SendGiftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendGiftReq.ProtoReflect.Descriptor instead.
+func (*SendGiftReq) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SendGiftReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SendGiftReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SendGiftReq) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *SendGiftReq) GetSkinType() int32 {
+	if x != nil {
+		return x.SkinType
+	}
+	return 0
+}
+
+func (x *SendGiftReq) GetSkinID() int32 {
+	if x != nil {
+		return x.SkinID
+	}
+	return 0
+}
+
+func (x *SendGiftReq) GetCash() int32 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+type GiftID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GiftID string `protobuf:"bytes,1,opt,name=giftID,proto3" json:"giftID,omitempty"`
+}
+
+func (x *GiftID) Reset() {
+	*x = GiftID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftID) ProtoMessage() {}
+
+func (x *GiftID) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftID.ProtoReflect.Descriptor instead.
+func (*GiftID) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GiftID) GetGiftID() string {
+	if x != nil {
+		return x.GiftID
+	}
+	return ""
+}
+
+type Gift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GiftID       string `protobuf:"bytes,1,opt,name=giftID,proto3" json:"giftID,omitempty"`
+	FromUserName string `protobuf:"bytes,2,opt,name=fromUserName,proto3" json:"fromUserName,omitempty"`
+	ToUserName   string `protobuf:"bytes,3,opt,name=toUserName,proto3" json:"toUserName,omitempty"`
+	SkinType     int32  `protobuf:"varint,4,opt,name=skinType,proto3" json:"skinType,omitempty"` // 0 for a cash gift
+	SkinID       int32  `protobuf:"varint,5,opt,name=skinID,proto3" json:"skinID,omitempty"`
+	Cash         int32  `protobuf:"varint,6,opt,name=cash,proto3" json:"cash,omitempty"`
+	CreatedAt    int64  `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt    int64  `protobuf:"varint,8,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *Gift) Reset() {
+	*x = Gift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Gift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gift) ProtoMessage() {}
+
+func (x *Gift) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gift.ProtoReflect.Descriptor instead.
+func (*Gift) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Gift) GetGiftID() string {
+	if x != nil {
+		return x.GiftID
+	}
+	return ""
+}
+
+func (x *Gift) GetFromUserName() string {
+	if x != nil {
+		return x.FromUserName
+	}
+	return ""
+}
+
+func (x *Gift) GetToUserName() string {
+	if x != nil {
+		return x.ToUserName
+	}
+	return ""
+}
+
+func (x *Gift) GetSkinType() int32 {
+	if x != nil {
+		return x.SkinType
+	}
+	return 0
+}
+
+func (x *Gift) GetSkinID() int32 {
+	if x != nil {
+		return x.SkinID
+	}
+	return 0
+}
+
+func (x *Gift) GetCash() int32 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *Gift) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Gift) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type GiftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	GiftID       string `protobuf:"bytes,3,opt,name=giftID,proto3" json:"giftID,omitempty"`
+	Accept       bool   `protobuf:"varint,4,opt,name=accept,proto3" json:"accept,omitempty"` // declined gifts go back to the sender
+}
+
+func (x *GiftResponse) Reset() {
+	*x = GiftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftResponse) ProtoMessage() {}
+
+func (x *GiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftResponse.ProtoReflect.Descriptor instead.
+func (*GiftResponse) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GiftResponse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GiftResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *GiftResponse) GetGiftID() string {
+	if x != nil {
+		return x.GiftID
+	}
+	return ""
+}
+
+func (x *GiftResponse) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type GiftsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+}
+
+func (x *GiftsReq) Reset() {
+	*x = GiftsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftsReq) ProtoMessage() {}
+
+func (x *GiftsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftsReq.ProtoReflect.Descriptor instead.
+func (*GiftsReq) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GiftsReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *GiftsReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type GiftList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Received []*Gift `protobuf:"bytes,1,rep,name=received,proto3" json:"received,omitempty"` // waiting for this player's answer
+	Sent     []*Gift `protobuf:"bytes,2,rep,name=sent,proto3" json:"sent,omitempty"`         // waiting for the friend's answer
+}
+
+func (x *GiftList) Reset() {
+	*x = GiftList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftList) ProtoMessage() {}
+
+func (x *GiftList) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftList.ProtoReflect.Descriptor instead.
+func (*GiftList) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GiftList) GetReceived() []*Gift {
+	if x != nil {
+		return x.Received
+	}
+	return nil
+}
+
+func (x *GiftList) GetSent() []*Gift {
+	if x != nil {
+		return x.Sent
+	}
+	return nil
+}
+
+type ModerationNotice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action ModerationAction `protobuf:"varint,1,opt,name=action,proto3,enum=AirHockey.ModerationAction" json:"action,omitempty"`
+	Reason string           `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Until  int64            `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"` // unix seconds, 0 when permanent or a warning
+}
+
+func (x *ModerationNotice) Reset() {
+	*x = ModerationNotice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModerationNotice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationNotice) ProtoMessage() {}
+
+func (x *ModerationNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationNotice.ProtoReflect.Descriptor instead.
+func (*ModerationNotice) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ModerationNotice) GetAction() ModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationAction_WARN
+}
+
+func (x *ModerationNotice) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ModerationNotice) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+type MuteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	UserName     string `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	Mute         bool   `protobuf:"varint,4,opt,name=mute,proto3" json:"mute,omitempty"` // false to unmute
+}
+
+func (x *MuteRequest) Reset() {
+	*x = MuteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteRequest) ProtoMessage() {}
+
+func (x *MuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteRequest.ProtoReflect.Descriptor instead.
+func (*MuteRequest) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MuteRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *MuteRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *MuteRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *MuteRequest) GetMute() bool {
+	if x != nil {
+		return x.Mute
+	}
+	return false
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *LogoutRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *LogoutRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type SessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+}
+
+func (x *SessionReq) Reset() {
+	*x = SessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionReq) ProtoMessage() {}
+
+func (x *SessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionReq.ProtoReflect.Descriptor instead.
+func (*SessionReq) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SessionReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SessionReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type FriendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	UserName     string `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"` // the other player
+}
+
+func (x *FriendRequest) Reset() {
+	*x = FriendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRequest) ProtoMessage() {}
+
+func (x *FriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRequest.ProtoReflect.Descriptor instead.
+func (*FriendRequest) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *FriendRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *FriendRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *FriendRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type Friend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName string `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Online   bool   `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
+}
+
+func (x *Friend) Reset() {
+	*x = Friend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Friend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Friend) ProtoMessage() {}
+
+func (x *Friend) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Friend.ProtoReflect.Descriptor instead.
+func (*Friend) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Friend) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Friend) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Friend) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+type PresenceUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName string         `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Status   PresenceStatus `protobuf:"varint,2,opt,name=status,proto3,enum=AirHockey.PresenceStatus" json:"status,omitempty"`
+}
+
+func (x *PresenceUpdate) Reset() {
+	*x = PresenceUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresenceUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceUpdate) ProtoMessage() {}
+
+func (x *PresenceUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceUpdate.ProtoReflect.Descriptor instead.
+func (*PresenceUpdate) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PresenceUpdate) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *PresenceUpdate) GetStatus() PresenceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PresenceStatus_OFFLINE
+}
+
+type FriendList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Friends  []*Friend `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
+	Incoming []string  `protobuf:"bytes,2,rep,name=incoming,proto3" json:"incoming,omitempty"` // user names waiting for an answer
+	Outgoing []string  `protobuf:"bytes,3,rep,name=outgoing,proto3" json:"outgoing,omitempty"`
+	Blocked  []string  `protobuf:"bytes,4,rep,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (x *FriendList) Reset() {
+	*x = FriendList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendList) ProtoMessage() {}
+
+func (x *FriendList) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendList.ProtoReflect.Descriptor instead.
+func (*FriendList) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FriendList) GetFriends() []*Friend {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+func (x *FriendList) GetIncoming() []string {
+	if x != nil {
+		return x.Incoming
+	}
+	return nil
+}
+
+func (x *FriendList) GetOutgoing() []string {
+	if x != nil {
+		return x.Outgoing
+	}
+	return nil
+}
+
+func (x *FriendList) GetBlocked() []string {
+	if x != nil {
+		return x.Blocked
+	}
+	return nil
+}
+
+type PlayerInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Direction *Direction `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"`
+	Sender    string     `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	RoomID    string     `protobuf:"bytes,3,opt,name=roomID,proto3" json:"roomID,omitempty"`
+}
+
+func (x *PlayerInput) Reset() {
+	*x = PlayerInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerInput) ProtoMessage() {}
+
+func (x *PlayerInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerInput.ProtoReflect.Descriptor instead.
+func (*PlayerInput) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PlayerInput) GetDirection() *Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return nil
+}
+
+func (x *PlayerInput) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *PlayerInput) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+type EntityState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*ObjectState `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	Puck    *ObjectState   `protobuf:"bytes,2,opt,name=puck,proto3" json:"puck,omitempty"`
+	Sender  string         `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	RoomID  string         `protobuf:"bytes,4,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
+}
+
+func (x *EntityState) Reset() {
+	*x = EntityState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityState) ProtoMessage() {}
+
+func (x *EntityState) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityState.ProtoReflect.Descriptor instead.
+func (*EntityState) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *EntityState) GetPlayers() []*ObjectState {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *EntityState) GetPuck() *ObjectState {
+	if x != nil {
+		return x.Puck
+	}
+	return nil
+}
+
+func (x *EntityState) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EntityState) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+type GameState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsPlaying  int32      `protobuf:"varint,1,opt,name=isPlaying,proto3" json:"isPlaying,omitempty"`
+	ScoreTeam1 int32      `protobuf:"varint,2,opt,name=scoreTeam1,proto3" json:"scoreTeam1,omitempty"`
+	ScoreTeam2 int32      `protobuf:"varint,3,opt,name=scoreTeam2,proto3" json:"scoreTeam2,omitempty"`
+	RoomID     string     `protobuf:"bytes,4,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
+	Loadouts   []*Loadout `protobuf:"bytes,5,rep,name=loadouts,proto3" json:"loadouts,omitempty"` // filled by the server when the room starts playing
+	Notice     string     `protobuf:"bytes,6,opt,name=notice,proto3" json:"notice,omitempty"`     // set by the server when it refuses a state change
+}
+
+func (x *GameState) Reset() {
+	*x = GameState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState) ProtoMessage() {}
+
+func (x *GameState) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GameState) GetIsPlaying() int32 {
+	if x != nil {
+		return x.IsPlaying
+	}
+	return 0
+}
+
+func (x *GameState) GetScoreTeam1() int32 {
+	if x != nil {
+		return x.ScoreTeam1
+	}
+	return 0
+}
+
+func (x *GameState) GetScoreTeam2() int32 {
+	if x != nil {
+		return x.ScoreTeam2
+	}
+	return 0
+}
+
+func (x *GameState) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *GameState) GetLoadouts() []*Loadout {
+	if x != nil {
+		return x.Loadouts
+	}
+	return nil
+}
+
+func (x *GameState) GetNotice() string {
+	if x != nil {
+		return x.Notice
+	}
+	return ""
+}
+
+type Loadout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName    string `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	PuckSkin    int32  `protobuf:"varint,2,opt,name=puckSkin,proto3" json:"puckSkin,omitempty"` // 0 is the default skin
+	StrikerSkin int32  `protobuf:"varint,3,opt,name=strikerSkin,proto3" json:"strikerSkin,omitempty"`
+	TableSkin   int32  `protobuf:"varint,4,opt,name=tableSkin,proto3" json:"tableSkin,omitempty"`
+}
+
+func (x *Loadout) Reset() {
+	*x = Loadout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Loadout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loadout) ProtoMessage() {}
+
+func (x *Loadout) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loadout.ProtoReflect.Descriptor instead.
+func (*Loadout) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Loadout) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Loadout) GetPuckSkin() int32 {
+	if x != nil {
+		return x.PuckSkin
+	}
+	return 0
+}
+
+func (x *Loadout) GetStrikerSkin() int32 {
+	if x != nil {
+		return x.StrikerSkin
+	}
+	return 0
+}
+
+func (x *Loadout) GetTableSkin() int32 {
+	if x != nil {
+		return x.TableSkin
+	}
+	return 0
+}
+
+type EquipSkinReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	SkinType     int32  `protobuf:"varint,3,opt,name=SkinType,proto3" json:"SkinType,omitempty"` // 1 puck, 2 striker, 3 table
+	SkinID       int32  `protobuf:"varint,4,opt,name=SkinID,proto3" json:"SkinID,omitempty"`     // 0 goes back to the default skin
+}
+
+func (x *EquipSkinReq) Reset() {
+	*x = EquipSkinReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EquipSkinReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipSkinReq) ProtoMessage() {}
+
+func (x *EquipSkinReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipSkinReq.ProtoReflect.Descriptor instead.
+func (*EquipSkinReq) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *EquipSkinReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *EquipSkinReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *EquipSkinReq) GetSkinType() int32 {
+	if x != nil {
+		return x.SkinType
+	}
+	return 0
+}
+
+func (x *EquipSkinReq) GetSkinID() int32 {
+	if x != nil {
+		return x.SkinID
+	}
+	return 0
+}
+
+type LeaveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomID       string      `protobuf:"bytes,1,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
+	PlayerInfo   *PlayerInfo `protobuf:"bytes,2,opt,name=playerInfo,proto3" json:"playerInfo,omitempty"`
+	SessionToken string      `protobuf:"bytes,3,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+}
+
+func (x *LeaveRequest) Reset() {
+	*x = LeaveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveRequest) ProtoMessage() {}
+
+func (x *LeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveRequest.ProtoReflect.Descriptor instead.
+func (*LeaveRequest) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *LeaveRequest) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *LeaveRequest) GetPlayerInfo() *PlayerInfo {
+	if x != nil {
+		return x.PlayerInfo
+	}
+	return nil
+}
+
+func (x *LeaveRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type JoinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomID       string      `protobuf:"bytes,1,opt,name=RoomID,proto3" json:"RoomID,omitempty"`
+	PlayerInfo   *PlayerInfo `protobuf:"bytes,2,opt,name=playerInfo,proto3" json:"playerInfo,omitempty"`
+	SessionToken string      `protobuf:"bytes,3,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+}
+
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequest) ProtoMessage() {}
+
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *JoinRequest) GetRoomID() string {
+	if x != nil {
+		return x.RoomID
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetPlayerInfo() *PlayerInfo {
+	if x != nil {
+		return x.PlayerInfo
+	}
+	return nil
+}
+
+func (x *JoinRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type NewGameInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NumberOfPlayer int32  `protobuf:"varint,1,opt,name=numberOfPlayer,proto3" json:"numberOfPlayer,omitempty"`
+	TargetScore    int32  `protobuf:"varint,2,opt,name=targetScore,proto3" json:"targetScore,omitempty"`
+	Host           string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	IsPrivate      bool   `protobuf:"varint,4,opt,name=isPrivate,proto3" json:"isPrivate,omitempty"`      // only joinable by room ID, never by quick join
+	Stake          int32  `protobuf:"varint,5,opt,name=stake,proto3" json:"stake,omitempty"`              // cash each player puts in the pot, 0 for no wager, never quick joined
+	SessionToken   string `protobuf:"bytes,6,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"` // the host's session
+}
+
+func (x *NewGameInfo) Reset() {
+	*x = NewGameInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewGameInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewGameInfo) ProtoMessage() {}
+
+func (x *NewGameInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewGameInfo.ProtoReflect.Descriptor instead.
+func (*NewGameInfo) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *NewGameInfo) GetNumberOfPlayer() int32 {
+	if x != nil {
+		return x.NumberOfPlayer
+	}
+	return 0
+}
+
+func (x *NewGameInfo) GetTargetScore() int32 {
+	if x != nil {
+		return x.TargetScore
+	}
+	return 0
+}
+
+func (x *NewGameInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *NewGameInfo) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *NewGameInfo) GetStake() int32 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *NewGameInfo) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type RoomID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UniqueID string `protobuf:"bytes,1,opt,name=uniqueID,proto3" json:"uniqueID,omitempty"`
+	Stake    int32  `protobuf:"varint,2,opt,name=stake,proto3" json:"stake,omitempty"` // set when joining a wager room
+}
+
+func (x *RoomID) Reset() {
+	*x = RoomID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomID) ProtoMessage() {}
+
+func (x *RoomID) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomID.ProtoReflect.Descriptor instead.
+func (*RoomID) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RoomID) GetUniqueID() string {
+	if x != nil {
+		return x.UniqueID
+	}
+	return ""
+}
+
+func (x *RoomID) GetStake() int32 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+type ObjectState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X  float32 `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y  float32 `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Z  float32 `protobuf:"fixed32,3,opt,name=z,proto3" json:"z,omitempty"`
+	Vx float32 `protobuf:"fixed32,4,opt,name=vx,proto3" json:"vx,omitempty"`
+	Vy float32 `protobuf:"fixed32,5,opt,name=vy,proto3" json:"vy,omitempty"`
+	Vz float32 `protobuf:"fixed32,6,opt,name=vz,proto3" json:"vz,omitempty"`
+}
+
+func (x *ObjectState) Reset() {
+	*x = ObjectState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectState) ProtoMessage() {}
+
+func (x *ObjectState) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectState.ProtoReflect.Descriptor instead.
+func (*ObjectState) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ObjectState) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ObjectState) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ObjectState) GetZ() float32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *ObjectState) GetVx() float32 {
+	if x != nil {
+		return x.Vx
+	}
+	return 0
+}
+
+func (x *ObjectState) GetVy() float32 {
+	if x != nil {
+		return x.Vy
+	}
+	return 0
+}
+
+func (x *ObjectState) GetVz() float32 {
+	if x != nil {
+		return x.Vz
+	}
+	return 0
+}
+
+type Direction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Input:
+	//	*Direction_KeyboardInput
+	//	*Direction_MouseInput
+	Input isDirection_Input `protobuf_oneof:"input"`
+}
+
+func (x *Direction) Reset() {
+	*x = Direction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Direction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Direction) ProtoMessage() {}
+
+func (x *Direction) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Direction.ProtoReflect.Descriptor instead.
+func (*Direction) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{75}
+}
+
+func (m *Direction) GetInput() isDirection_Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (x *Direction) GetKeyboardInput() *KeyboardInput {
+	if x, ok := x.GetInput().(*Direction_KeyboardInput); ok {
+		return x.KeyboardInput
+	}
+	return nil
+}
+
+func (x *Direction) GetMouseInput() *MouseInput {
+	if x, ok := x.GetInput().(*Direction_MouseInput); ok {
+		return x.MouseInput
+	}
+	return nil
+}
+
+type isDirection_Input interface {
+	isDirection_Input()
+}
+
+type Direction_KeyboardInput struct {
+	KeyboardInput *KeyboardInput `protobuf:"bytes,1,opt,name=keyboardInput,proto3,oneof"`
+}
+
+type Direction_MouseInput struct {
+	MouseInput *MouseInput `protobuf:"bytes,2,opt,name=mouseInput,proto3,oneof"`
+}
+
+func (*Direction_KeyboardInput) isDirection_Input() {}
+
+func (*Direction_MouseInput) isDirection_Input() {}
+
+type KeyboardInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UP    bool `protobuf:"varint,1,opt,name=UP,proto3" json:"UP,omitempty"`
+	DOWN  bool `protobuf:"varint,2,opt,name=DOWN,proto3" json:"DOWN,omitempty"`
+	LEFT  bool `protobuf:"varint,3,opt,name=LEFT,proto3" json:"LEFT,omitempty"`
+	RIGHT bool `protobuf:"varint,4,opt,name=RIGHT,proto3" json:"RIGHT,omitempty"`
+}
+
+func (x *KeyboardInput) Reset() {
+	*x = KeyboardInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyboardInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyboardInput) ProtoMessage() {}
+
+func (x *KeyboardInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyboardInput.ProtoReflect.Descriptor instead.
+func (*KeyboardInput) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *KeyboardInput) GetUP() bool {
+	if x != nil {
+		return x.UP
+	}
+	return false
+}
+
+func (x *KeyboardInput) GetDOWN() bool {
+	if x != nil {
+		return x.DOWN
+	}
+	return false
+}
+
+func (x *KeyboardInput) GetLEFT() bool {
+	if x != nil {
+		return x.LEFT
+	}
+	return false
+}
+
+func (x *KeyboardInput) GetRIGHT() bool {
+	if x != nil {
+		return x.RIGHT
+	}
+	return false
+}
+
+type MouseInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X         float32 `protobuf:"fixed32,1,opt,name=X,proto3" json:"X,omitempty"`
+	Y         float32 `protobuf:"fixed32,2,opt,name=Y,proto3" json:"Y,omitempty"`
+	IsClicked bool    `protobuf:"varint,3,opt,name=isClicked,proto3" json:"isClicked,omitempty"`
+}
+
+func (x *MouseInput) Reset() {
+	*x = MouseInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MouseInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MouseInput) ProtoMessage() {}
+
+func (x *MouseInput) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MouseInput.ProtoReflect.Descriptor instead.
+func (*MouseInput) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MouseInput) GetX() float32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *MouseInput) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *MouseInput) GetIsClicked() bool {
+	if x != nil {
+		return x.IsClicked
+	}
+	return false
+}
+
+type NewPlayerName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid         string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,3,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+}
+
+func (x *NewPlayerName) Reset() {
+	*x = NewPlayerName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NewPlayerName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPlayerName) ProtoMessage() {}
+
+func (x *NewPlayerName) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPlayerName.ProtoReflect.Descriptor instead.
+func (*NewPlayerName) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *NewPlayerName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NewPlayerName) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *NewPlayerName) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type PlayerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+}
+
+func (x *PlayerInfo) Reset() {
+	*x = PlayerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerInfo) ProtoMessage() {}
+
+func (x *PlayerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerInfo.ProtoReflect.Descriptor instead.
+func (*PlayerInfo) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PlayerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type LoginPlayerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uuid         string       `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Cash         int32        `protobuf:"varint,3,opt,name=cash,proto3" json:"cash,omitempty"`
+	Rank         int32        `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	Guest        bool         `protobuf:"varint,5,opt,name=guest,proto3" json:"guest,omitempty"`
+	SessionToken string       `protobuf:"bytes,6,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"` // needed for Logout
+	DailyReward  *DailyReward `protobuf:"bytes,7,opt,name=dailyReward,proto3" json:"dailyReward,omitempty"`   // the reward claimed by this login, if any
+}
+
+func (x *LoginPlayerInfo) Reset() {
+	*x = LoginPlayerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginPlayerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginPlayerInfo) ProtoMessage() {}
+
+func (x *LoginPlayerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginPlayerInfo.ProtoReflect.Descriptor instead.
+func (*LoginPlayerInfo) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *LoginPlayerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LoginPlayerInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *LoginPlayerInfo) GetCash() int32 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+func (x *LoginPlayerInfo) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LoginPlayerInfo) GetGuest() bool {
+	if x != nil {
+		return x.Guest
+	}
+	return false
+}
+
+func (x *LoginPlayerInfo) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *LoginPlayerInfo) GetDailyReward() *DailyReward {
+	if x != nil {
+		return x.DailyReward
+	}
+	return nil
+}
+
+type DailyRewardReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid         string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SessionToken string `protobuf:"bytes,2,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+}
+
+func (x *DailyRewardReq) Reset() {
+	*x = DailyRewardReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyRewardReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyRewardReq) ProtoMessage() {}
+
+func (x *DailyRewardReq) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyRewardReq.ProtoReflect.Descriptor instead.
+func (*DailyRewardReq) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DailyRewardReq) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *DailyRewardReq) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+type DailyReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claimed     bool  `protobuf:"varint,1,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	Reward      int32 `protobuf:"varint,2,opt,name=reward,proto3" json:"reward,omitempty"`
+	Streak      int32 `protobuf:"varint,3,opt,name=streak,proto3" json:"streak,omitempty"`           // consecutive days, 1 after a missed day
+	NextClaimAt int64 `protobuf:"varint,4,opt,name=nextClaimAt,proto3" json:"nextClaimAt,omitempty"` // unix seconds of the next server day
+	Cash        int32 `protobuf:"varint,5,opt,name=cash,proto3" json:"cash,omitempty"`               // balance after the claim
+}
+
+func (x *DailyReward) Reset() {
+	*x = DailyReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyReward) ProtoMessage() {}
+
+func (x *DailyReward) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyReward.ProtoReflect.Descriptor instead.
+func (*DailyReward) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DailyReward) GetClaimed() bool {
+	if x != nil {
+		return x.Claimed
+	}
+	return false
+}
+
+func (x *DailyReward) GetReward() int32 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *DailyReward) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *DailyReward) GetNextClaimAt() int64 {
+	if x != nil {
+		return x.NextClaimAt
+	}
+	return 0
+}
+
+func (x *DailyReward) GetCash() int32 {
+	if x != nil {
+		return x.Cash
+	}
+	return 0
+}
+
+type PlayerID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int32 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *PlayerID) Reset() {
+	*x = PlayerID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerID) ProtoMessage() {}
+
+func (x *PlayerID) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerID.ProtoReflect.Descriptor instead.
+func (*PlayerID) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PlayerID) GetID() int32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+type PlayerList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []string `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *PlayerList) Reset() {
+	*x = PlayerList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerList) ProtoMessage() {}
+
+func (x *PlayerList) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerList.ProtoReflect.Descriptor instead.
+func (*PlayerList) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PlayerList) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_airHockey_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_airHockey_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_pb_airHockey_proto_rawDescGZIP(), []int{85}
+}
+
+var File_pb_airHockey_proto protoreflect.FileDescriptor
+
+var file_pb_airHockey_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x62, 0x2f, 0x61, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x22,
+	0x5c, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x4e, 0x65, 0x77, 0x53, 0x6b, 0x69, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x6b, 0x69,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x53, 0x6b, 0x69,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6b, 0x69, 0x6e, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x6b, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x62, 0x0a,
+	0x0c, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x7f, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x2e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0xb2, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x22, 0x94, 0x01, 0x0a, 0x08, 0x53, 0x6b, 0x69, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x75, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x4c, 0x69,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x0b,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x6b, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x53,
+	0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x53,
+	0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6b, 0x69, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x6b, 0x69, 0x6e, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22,
+	0xc3, 0x01, 0x0a, 0x09, 0x53, 0x68, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x2a, 0x0a,
+	0x04, 0x73, 0x6b, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53,
+	0x6b, 0x69, 0x6e, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f,
+	0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x6e, 0x64, 0x73, 0x41, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09,
+	0x73, 0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x64,
+	0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41,
+	0x74, 0x22, 0x66, 0x0a, 0x04, 0x53, 0x68, 0x6f, 0x70, 0x12, 0x30, 0x0a, 0x08, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x53, 0x6b, 0x69,
+	0x6e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
+	0x6b, 0x65, 0x79, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x6b, 0x69, 0x6e, 0x52,
+	0x05, 0x73, 0x6b, 0x69, 0x6e, 0x73, 0x22, 0x7d, 0x0a, 0x0f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x73, 0x65, 0x53, 0x6b, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x53, 0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x53, 0x6b, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53,
+	0x6b, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x0e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x61, 0x73, 0x68, 0x12, 0x29, 0x0a, 0x05, 0x73,
+	0x6b, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x41, 0x69, 0x72,
+	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x6b, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x05, 0x73, 0x6b, 0x69, 0x6e, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x6b, 0x41,
+	0x6e, 0x64, 0x43, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x43, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x77,
+	0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x73, 0x68,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x51, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x43, 0x61, 0x73,
+	0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x21, 0x0a, 0x0b, 0x43, 0x61, 0x73, 0x68, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x61,
+	0x73, 0x68, 0x22, 0x46, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
+	0x65, 0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x0b, 0x72,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x0a, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x61, 0x6e, 0x6b,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x61, 0x6e,
+	0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x31, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x32,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x32, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0x1e, 0x0a,
+	0x08, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x6b, 0x0a,
+	0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
+	0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
+	0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x5c, 0x0a, 0x0e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x0b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x6f, 0x0a, 0x0d, 0x4e, 0x65, 0x77, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xa7, 0x01, 0x0a, 0x0d, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
+	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x28, 0x0a, 0x10, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x4a, 0x0a,
+	0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65,
+	0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x41, 0x0a, 0x07, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x92, 0x06, 0x0a,
+	0x0b, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x0b,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
+	0x6b, 0x65, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48,
+	0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x41, 0x69, 0x72,
+	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
+	0x6b, 0x65, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x10,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
+	0x65, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x48, 0x00, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41,
+	0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45,
+	0x6d, 0x6f, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x49, 0x0a,
+	0x10, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
+	0x6b, 0x65, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x74, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x61, 0x63, 0x68, 0x69,
+	0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
+	0x79, 0x2e, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x13, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x67, 0x69, 0x66, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x47,
+	0x69, 0x66, 0x74, 0x48, 0x00, 0x52, 0x04, 0x67, 0x69, 0x66, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x26, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7b, 0x0a, 0x0d, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f,
+	0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x6e,
+	0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x22, 0x8f,
+	0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x5d, 0x0a, 0x05, 0x45, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x66,
+	0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xc6, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
+	0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x26, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44,
+	0x22, 0x72, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x22, 0xc2, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x22, 0x39, 0x0a, 0x0a, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
+	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x44, 0x22, 0xfb, 0x01, 0x0a, 0x0b, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x43, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x43, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x53, 0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x6b, 0x69, 0x6e, 0x49, 0x44, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x53, 0x6b, 0x69,
+	0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x49, 0x0a, 0x0f, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x0f, 0x41, 0x63,
+	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a,
+	0x0c, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
+	0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x61, 0x63, 0x68,
+	0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x07, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x43, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x43, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x41, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x41, 0x74, 0x22, 0x45, 0x0a,
+	0x0b, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3d, 0x0a, 0x0b, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
+	0x79, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x67, 0x0a, 0x0f, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xa9, 0x01, 0x0a,
+	0x0b, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x73, 0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x6b, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6b,
+	0x69, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x61, 0x73, 0x68, 0x22, 0x20, 0x0a, 0x06, 0x47, 0x69, 0x66, 0x74,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x69, 0x66, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x66, 0x74, 0x49, 0x44, 0x22, 0xe6, 0x01, 0x0a, 0x04, 0x47,
+	0x69, 0x66, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x69, 0x66, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x66, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x66,
+	0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x73, 0x6b, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6b, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x6b, 0x69,
+	0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x63, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x22, 0x76, 0x0a, 0x0c, 0x47, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x67,
+	0x69, 0x66, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x66,
+	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x42, 0x0a, 0x08, 0x47,
+	0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x5c, 0x0a, 0x08, 0x47, 0x69, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
+	0x65, 0x79, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x22, 0x75, 0x0a,
+	0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63,
+	0x65, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x22, 0x75, 0x0a, 0x0b, 0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x0d, 0x4c,
+	0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x63, 0x0a, 0x0d, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x50, 0x0a, 0x06, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x22, 0x5f, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0a, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x2b, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75,
+	0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x22, 0x71, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x32, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f,
+	0x6d, 0x49, 0x44, 0x22, 0x9b, 0x01, 0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x75, 0x63, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x70, 0x75, 0x63,
+	0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6f,
+	0x6d, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49,
+	0x44, 0x22, 0xc9, 0x01, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x69, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x31, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x54, 0x65, 0x61, 0x6d, 0x32, 0x12, 0x16, 0x0a,
+	0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52,
+	0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x6f, 0x75, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
+	0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x6c, 0x6f, 0x61,
+	0x64, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x22, 0x81, 0x01,
+	0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x75, 0x63, 0x6b, 0x53, 0x6b, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x75, 0x63, 0x6b, 0x53, 0x6b, 0x69,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x72, 0x53, 0x6b, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x72, 0x53,
+	0x6b, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x6b, 0x69, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x6b, 0x69,
+	0x6e, 0x22, 0x7a, 0x0a, 0x0c, 0x45, 0x71, 0x75, 0x69, 0x70, 0x53, 0x6b, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x6b, 0x69,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x53, 0x6b, 0x69,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6b, 0x69, 0x6e, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x53, 0x6b, 0x69, 0x6e, 0x49, 0x44, 0x22, 0x81, 0x01,
+	0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x41, 0x69, 0x72,
+	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc3, 0x01, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3a, 0x0a, 0x06, 0x52, 0x6f,
+	0x6f, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0x67, 0x0a, 0x0b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01,
+	0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x7a, 0x12,
+	0x0e, 0x0a, 0x02, 0x76, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x76, 0x78, 0x12,
+	0x0e, 0x0a, 0x02, 0x76, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x76, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x76, 0x7a, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x76, 0x7a, 0x22,
+	0x8f, 0x01, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a,
+	0x0d, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
+	0x2e, 0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x00,
+	0x52, 0x0d, 0x6b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x37, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
+	0x4d, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x6f,
+	0x75, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x22, 0x5d, 0x0a, 0x0d, 0x4b, 0x65, 0x79, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x55, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02,
+	0x55, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x45, 0x46, 0x54, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4c, 0x45, 0x46, 0x54, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x49,
+	0x47, 0x48, 0x54, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x52, 0x49, 0x47, 0x48, 0x54,
+	0x22, 0x46, 0x0a, 0x0a, 0x4d, 0x6f, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0c,
+	0x0a, 0x01, 0x58, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x58, 0x12, 0x0c, 0x0a, 0x01,
+	0x59, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x59, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73,
+	0x43, 0x6c, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69,
+	0x73, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x5b, 0x0a, 0x0d, 0x4e, 0x65, 0x77, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x34, 0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x0f,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x67, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x64, 0x61, 0x69,
+	0x6c, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x22, 0x48, 0x0a, 0x0e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8d, 0x01,
+	0x0a, 0x0b, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x73,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x61, 0x73, 0x68, 0x22, 0x1a, 0x0a,
+	0x08, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x49, 0x44, 0x22, 0x26, 0x0a, 0x0a, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x2a, 0x22, 0x0a, 0x0b, 0x43, 0x68,
+	0x61, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x4f, 0x4f,
+	0x4d, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x10, 0x01, 0x2a, 0x42,
+	0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x09,
+	0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x48, 0x45,
+	0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x42, 0x55, 0x53, 0x49,
+	0x56, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x54, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x46, 0x4b,
+	0x10, 0x03, 0x2a, 0x4c, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x55, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x54, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x54, 0x45, 0x4d, 0x50, 0x5f, 0x42, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a,
+	0x0d, 0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x4e, 0x10, 0x03,
+	0x2a, 0x45, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49,
+	0x4e, 0x5f, 0x4c, 0x4f, 0x42, 0x42, 0x59, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x5f,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x32, 0xd9, 0x19, 0x0a, 0x10, 0x41, 0x69, 0x72, 0x48,
+	0x6f, 0x63, 0x6b, 0x65, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0a,
+	0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x41, 0x69, 0x72,
+	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x12, 0x12, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x1a, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
+	0x79, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x73, 0x47, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
+	0x79, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x47, 0x75, 0x65, 0x73,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
+	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x47, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x2e, 0x41, 0x69, 0x72,
+	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x10, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x41,
+	0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
+	0x6b, 0x65, 0x79, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
+	0x65, 0x79, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
+	0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x14, 0x44, 0x65, 0x63,
+	0x6c, 0x69, 0x6e, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12,
+	0x18, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48,
+	0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a,
+	0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x41,
+	0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
+	0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0d, 0x55, 0x6e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
+	0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x41, 0x69, 0x72,
+	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x15, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x11, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x15, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
+	0x65, 0x79, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x4d, 0x75, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
+	0x4d, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x18, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x41, 0x69, 0x72, 0x48,
+	0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x22, 0x00,
+	0x12, 0x41, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x19, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x41, 0x69, 0x72,
+	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
+	0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x41, 0x69, 0x72, 0x48,
+	0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
+	0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x11, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4e, 0x65, 0x77,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72,
+	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x19, 0x2e,
+	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
+	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x2e,
+	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x2e,
+	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72,
+	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x1b, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x41,
+	0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4e, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x1a, 0x11, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
+	0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x41,
+	0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x22,
+	0x00, 0x12, 0x41, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x12, 0x18, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x41, 0x69, 0x72, 0x48,
+	0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x11, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
+	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x22, 0x00, 0x12, 0x42, 0x0a,
+	0x0a, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x16, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x35, 0x0a, 0x09, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x11,
+	0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x1a, 0x13, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x49, 0x44, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x41, 0x6e, 0x64, 0x43, 0x61, 0x73, 0x68, 0x12, 0x16, 0x2e,
+	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x41, 0x6e,
+	0x64, 0x43, 0x61, 0x73, 0x68, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
+	0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x07, 0x41, 0x64, 0x64,
+	0x53, 0x6b, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
+	0x2e, 0x41, 0x64, 0x64, 0x4e, 0x65, 0x77, 0x53, 0x6b, 0x69, 0x6e, 0x1a, 0x13, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x6b, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
+	0x79, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1a, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12,
+	0x37, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x69, 0x66, 0x74, 0x12, 0x16, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x69, 0x66, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e,
+	0x47, 0x69, 0x66, 0x74, 0x49, 0x44, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x47, 0x69, 0x66, 0x74, 0x12, 0x17, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
+	0x6b, 0x65, 0x79, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x69, 0x66, 0x74,
+	0x73, 0x12, 0x13, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x47, 0x69,
+	0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
+	0x65, 0x79, 0x2e, 0x47, 0x69, 0x66, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1a, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63, 0x68,
+	0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x41,
+	0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x41, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48,
+	0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0c, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
+	0x6b, 0x65, 0x79, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x00, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x43, 0x61, 0x73, 0x68, 0x12, 0x17,
+	0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x43, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63,
+	0x6b, 0x65, 0x79, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x00, 0x12, 0x3b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x11, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52,
+	0x6f, 0x6f, 0x6d, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65,
+	0x79, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x38,
+	0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x17, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x17, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
+	0x65, 0x79, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
+	0x65, 0x79, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f,
+	0x63, 0x6b, 0x65, 0x79, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74,
+	0x22, 0x00, 0x12, 0x39, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x6b, 0x69, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x13, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x13, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
+	0x65, 0x79, 0x2e, 0x53, 0x6b, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x3f, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x2e,
+	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b,
+	0x65, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x2e, 0x41, 0x69,
+	0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
+	0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x00,
+	0x12, 0x3c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x6b, 0x69, 0x6e, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x12, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
+	0x2e, 0x53, 0x6b, 0x69, 0x6e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x22, 0x00, 0x12, 0x47,
+	0x0a, 0x0c, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x53, 0x6b, 0x69, 0x6e, 0x12, 0x1a,
+	0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x53, 0x6b, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x41, 0x69, 0x72,
+	0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x53, 0x68,
+	0x6f, 0x70, 0x12, 0x10, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
+	0x2e, 0x53, 0x68, 0x6f, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x45, 0x71, 0x75, 0x69, 0x70,
+	0x53, 0x6b, 0x69, 0x6e, 0x12, 0x17, 0x2e, 0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79,
+	0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x53, 0x6b, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x41, 0x69, 0x72, 0x48, 0x6f, 0x63, 0x6b, 0x65, 0x79, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x6f, 0x75,
+	0x74, 0x22, 0x00, 0x42, 0x05, 0x5a, 0x03, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_pb_airHockey_proto_rawDescOnce sync.Once
+	file_pb_airHockey_proto_rawDescData = file_pb_airHockey_proto_rawDesc
+)
+
+func file_pb_airHockey_proto_rawDescGZIP() []byte {
+	file_pb_airHockey_proto_rawDescOnce.Do(func() {
+		file_pb_airHockey_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_airHockey_proto_rawDescData)
+	})
+	return file_pb_airHockey_proto_rawDescData
+}
+
+var file_pb_airHockey_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pb_airHockey_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
+var file_pb_airHockey_proto_goTypes = []interface{}{
+	(ChatChannel)(0),           // 0: AirHockey.ChatChannel
+	(ReportReason)(0),          // 1: AirHockey.ReportReason
+	(ModerationAction)(0),      // 2: AirHockey.ModerationAction
+	(PresenceStatus)(0),        // 3: AirHockey.PresenceStatus
+	(*AddNewSkin)(nil),         // 4: AirHockey.AddNewSkin
+	(*InventoryReq)(nil),       // 5: AirHockey.InventoryReq
+	(*InventoryItem)(nil),      // 6: AirHockey.InventoryItem
+	(*Inventory)(nil),          // 7: AirHockey.Inventory
+	(*GrantItemReq)(nil),       // 8: AirHockey.GrantItemReq
+	(*SkinList)(nil),           // 9: AirHockey.SkinList
+	(*CatalogSkin)(nil),        // 10: AirHockey.CatalogSkin
+	(*ShopOffer)(nil),          // 11: AirHockey.ShopOffer
+	(*ShopEvent)(nil),          // 12: AirHockey.ShopEvent
+	(*Shop)(nil),               // 13: AirHockey.Shop
+	(*SkinCatalog)(nil),        // 14: AirHockey.SkinCatalog
+	(*PurchaseSkinReq)(nil),    // 15: AirHockey.PurchaseSkinReq
+	(*PurchaseResult)(nil),     // 16: AirHockey.PurchaseResult
+	(*RankAndCash)(nil),        // 17: AirHockey.RankAndCash
+	(*TransactionsReq)(nil),    // 18: AirHockey.TransactionsReq
+	(*CashTransaction)(nil),    // 19: AirHockey.CashTransaction
+	(*TransactionList)(nil),    // 20: AirHockey.TransactionList
+	(*GrantCashReq)(nil),       // 21: AirHockey.GrantCashReq
+	(*CashBalance)(nil),        // 22: AirHockey.CashBalance
+	(*RankingList)(nil),        // 23: AirHockey.RankingList
+	(*PlayerRank)(nil),         // 24: AirHockey.PlayerRank
+	(*Record)(nil),             // 25: AirHockey.Record
+	(*RecordID)(nil),           // 26: AirHockey.RecordID
+	(*ChangePasswordReq)(nil),  // 27: AirHockey.ChangePasswordReq
+	(*ChangeEmailReq)(nil),     // 28: AirHockey.ChangeEmailReq
+	(*NewAccountReq)(nil),      // 29: AirHockey.NewAccountReq
+	(*ClaimGuestReq)(nil),      // 30: AirHockey.ClaimGuestReq
+	(*PasswordResetReq)(nil),   // 31: AirHockey.PasswordResetReq
+	(*ResetPasswordReq)(nil),   // 32: AirHockey.ResetPasswordReq
+	(*Account)(nil),            // 33: AirHockey.Account
+	(*GameMessage)(nil),        // 34: AirHockey.GameMessage
+	(*SessionEvent)(nil),       // 35: AirHockey.SessionEvent
+	(*InviteRequest)(nil),      // 36: AirHockey.InviteRequest
+	(*Invitation)(nil),         // 37: AirHockey.Invitation
+	(*InvitationResponse)(nil), // 38: AirHockey.InvitationResponse
+	(*InvitationAnswer)(nil),   // 39: AirHockey.InvitationAnswer
+	(*ChatMessage)(nil),        // 40: AirHockey.ChatMessage
+	(*Emote)(nil),              // 41: AirHockey.Emote
+	(*ReportRequest)(nil),      // 42: AirHockey.ReportRequest
+	(*ReportID)(nil),           // 43: AirHockey.ReportID
+	(*ListReportsReq)(nil),     // 44: AirHockey.ListReportsReq
+	(*Report)(nil),             // 45: AirHockey.Report
+	(*ReportList)(nil),         // 46: AirHockey.ReportList
+	(*ModerationRequest)(nil),  // 47: AirHockey.ModerationRequest
+	(*Achievement)(nil),        // 48: AirHockey.Achievement
+	(*AchievementsReq)(nil),    // 49: AirHockey.AchievementsReq
+	(*AchievementList)(nil),    // 50: AirHockey.AchievementList
+	(*Mission)(nil),            // 51: AirHockey.Mission
+	(*MissionsReq)(nil),        // 52: AirHockey.MissionsReq
+	(*MissionList)(nil),        // 53: AirHockey.MissionList
+	(*ClaimMissionReq)(nil),    // 54: AirHockey.ClaimMissionReq
+	(*SendGiftReq)(nil),        // 55: AirHockey.SendGiftReq
+	(*GiftID)(nil),             // 56: AirHockey.GiftID
+	(*Gift)(nil),               // 57: AirHockey.Gift
+	(*GiftResponse)(nil),       // 58: AirHockey.GiftResponse
+	(*GiftsReq)(nil),           // 59: AirHockey.GiftsReq
+	(*GiftList)(nil),           // 60: AirHockey.GiftList
+	(*ModerationNotice)(nil),   // 61: AirHockey.ModerationNotice
+	(*MuteRequest)(nil),        // 62: AirHockey.MuteRequest
+	(*LogoutRequest)(nil),      // 63: AirHockey.LogoutRequest
+	(*SessionReq)(nil),         // 64: AirHockey.SessionReq
+	(*FriendRequest)(nil),      // 65: AirHockey.FriendRequest
+	(*Friend)(nil),             // 66: AirHockey.Friend
+	(*PresenceUpdate)(nil),     // 67: AirHockey.PresenceUpdate
+	(*FriendList)(nil),         // 68: AirHockey.FriendList
+	(*PlayerInput)(nil),        // 69: AirHockey.PlayerInput
+	(*EntityState)(nil),        // 70: AirHockey.EntityState
+	(*GameState)(nil),          // 71: AirHockey.GameState
+	(*Loadout)(nil),            // 72: AirHockey.Loadout
+	(*EquipSkinReq)(nil),       // 73: AirHockey.EquipSkinReq
+	(*LeaveRequest)(nil),       // 74: AirHockey.LeaveRequest
+	(*JoinRequest)(nil),        // 75: AirHockey.JoinRequest
+	(*NewGameInfo)(nil),        // 76: AirHockey.NewGameInfo
+	(*RoomID)(nil),             // 77: AirHockey.RoomID
+	(*ObjectState)(nil),        // 78: AirHockey.ObjectState
+	(*Direction)(nil),          // 79: AirHockey.Direction
+	(*KeyboardInput)(nil),      // 80: AirHockey.KeyboardInput
+	(*MouseInput)(nil),         // 81: AirHockey.MouseInput
+	(*NewPlayerName)(nil),      // 82: AirHockey.NewPlayerName
+	(*PlayerInfo)(nil),         // 83: AirHockey.PlayerInfo
+	(*LoginPlayerInfo)(nil),    // 84: AirHockey.LoginPlayerInfo
+	(*DailyRewardReq)(nil),     // 85: AirHockey.DailyRewardReq
+	(*DailyReward)(nil),        // 86: AirHockey.DailyReward
+	(*PlayerID)(nil),           // 87: AirHockey.PlayerID
+	(*PlayerList)(nil),         // 88: AirHockey.PlayerList
+	(*Empty)(nil),              // 89: AirHockey.Empty
+}
+var file_pb_airHockey_proto_depIdxs = []int32{
+	6,  // 0: AirHockey.Inventory.items:type_name -> AirHockey.InventoryItem
+	10, // 1: AirHockey.ShopOffer.skin:type_name -> AirHockey.CatalogSkin
+	11, // 2: AirHockey.Shop.featured:type_name -> AirHockey.ShopOffer
+	12, // 3: AirHockey.Shop.events:type_name -> AirHockey.ShopEvent
+	10, // 4: AirHockey.SkinCatalog.skins:type_name -> AirHockey.CatalogSkin
+	9,  // 5: AirHockey.PurchaseResult.skins:type_name -> AirHockey.SkinList
+	19, // 6: AirHockey.TransactionList.transactions:type_name -> AirHockey.CashTransaction
+	24, // 7: AirHockey.RankingList.rankingList:type_name -> AirHockey.PlayerRank
+	33, // 8: AirHockey.ChangePasswordReq.accountInfo:type_name -> AirHockey.Account
+	33, // 9: AirHockey.ChangeEmailReq.accountInfo:type_name -> AirHockey.Account
+	33, // 10: AirHockey.NewAccountReq.accountInfo:type_name -> AirHockey.Account
+	33, // 11: AirHockey.ClaimGuestReq.accountInfo:type_name -> AirHockey.Account
+	69, // 12: AirHockey.GameMessage.playerInput:type_name -> AirHockey.PlayerInput
+	70, // 13: AirHockey.GameMessage.entityState:type_name -> AirHockey.EntityState
+	71, // 14: AirHockey.GameMessage.gameState:type_name -> AirHockey.GameState
+	89, // 15: AirHockey.GameMessage.empty:type_name -> AirHockey.Empty
+	35, // 16: AirHockey.GameMessage.sessionEvent:type_name -> AirHockey.SessionEvent
+	37, // 17: AirHockey.GameMessage.invitation:type_name -> AirHockey.Invitation
+	39, // 18: AirHockey.GameMessage.invitationAnswer:type_name -> AirHockey.InvitationAnswer
+	40, // 19: AirHockey.GameMessage.chatMessage:type_name -> AirHockey.ChatMessage
+	41, // 20: AirHockey.GameMessage.emote:type_name -> AirHockey.Emote
+	61, // 21: AirHockey.GameMessage.moderationNotice:type_name -> AirHockey.ModerationNotice
+	48, // 22: AirHockey.GameMessage.achievementUnlocked:type_name -> AirHockey.Achievement
+	57, // 23: AirHockey.GameMessage.gift:type_name -> AirHockey.Gift
+	0,  // 24: AirHockey.ChatMessage.channel:type_name -> AirHockey.ChatChannel
+	1,  // 25: AirHockey.ReportRequest.reason:type_name -> AirHockey.ReportReason
+	1,  // 26: AirHockey.Report.reason:type_name -> AirHockey.ReportReason
+	25, // 27: AirHockey.Report.recentRecords:type_name -> AirHockey.Record
+	45, // 28: AirHockey.ReportList.reports:type_name -> AirHockey.Report
+	2,  // 29: AirHockey.ModerationRequest.action:type_name -> AirHockey.ModerationAction
+	48, // 30: AirHockey.AchievementList.achievements:type_name -> AirHockey.Achievement
+	51, // 31: AirHockey.MissionList.missions:type_name -> AirHockey.Mission
+	57, // 32: AirHockey.GiftList.received:type_name -> AirHockey.Gift
+	57, // 33: AirHockey.GiftList.sent:type_name -> AirHockey.Gift
+	2,  // 34: AirHockey.ModerationNotice.action:type_name -> AirHockey.ModerationAction
+	3,  // 35: AirHockey.PresenceUpdate.status:type_name -> AirHockey.PresenceStatus
+	66, // 36: AirHockey.FriendList.friends:type_name -> AirHockey.Friend
+	79, // 37: AirHockey.PlayerInput.direction:type_name -> AirHockey.Direction
+	78, // 38: AirHockey.EntityState.players:type_name -> AirHockey.ObjectState
+	78, // 39: AirHockey.EntityState.puck:type_name -> AirHockey.ObjectState
+	72, // 40: AirHockey.GameState.loadouts:type_name -> AirHockey.Loadout
+	83, // 41: AirHockey.LeaveRequest.playerInfo:type_name -> AirHockey.PlayerInfo
+	83, // 42: AirHockey.JoinRequest.playerInfo:type_name -> AirHockey.PlayerInfo
+	80, // 43: AirHockey.Direction.keyboardInput:type_name -> AirHockey.KeyboardInput
+	81, // 44: AirHockey.Direction.mouseInput:type_name -> AirHockey.MouseInput
+	86, // 45: AirHockey.LoginPlayerInfo.dailyReward:type_name -> AirHockey.DailyReward
+	29, // 46: AirHockey.AirHockeyService.NewAccount:input_type -> AirHockey.NewAccountReq
+	33, // 47: AirHockey.AirHockeyService.Login:input_type -> AirHockey.Account
+	89, // 48: AirHockey.AirHockeyService.LoginAsGuest:input_type -> AirHockey.Empty
+	30, // 49: AirHockey.AirHockeyService.ClaimGuestAccount:input_type -> AirHockey.ClaimGuestReq
+	63, // 50: AirHockey.AirHockeyService.Logout:input_type -> AirHockey.LogoutRequest
+	85, // 51: AirHockey.AirHockeyService.ClaimDailyReward:input_type -> AirHockey.DailyRewardReq
+	65, // 52: AirHockey.AirHockeyService.SendFriendRequest:input_type -> AirHockey.FriendRequest
+	65, // 53: AirHockey.AirHockeyService.AcceptFriendRequest:input_type -> AirHockey.FriendRequest
+	65, // 54: AirHockey.AirHockeyService.DeclineFriendRequest:input_type -> AirHockey.FriendRequest
+	65, // 55: AirHockey.AirHockeyService.RemoveFriend:input_type -> AirHockey.FriendRequest
+	65, // 56: AirHockey.AirHockeyService.BlockPlayer:input_type -> AirHockey.FriendRequest
+	65, // 57: AirHockey.AirHockeyService.UnblockPlayer:input_type -> AirHockey.FriendRequest
+	64, // 58: AirHockey.AirHockeyService.GetFriendList:input_type -> AirHockey.SessionReq
+	64, // 59: AirHockey.AirHockeyService.SubscribePresence:input_type -> AirHockey.SessionReq
+	62, // 60: AirHockey.AirHockeyService.MutePlayer:input_type -> AirHockey.MuteRequest
+	42, // 61: AirHockey.AirHockeyService.ReportPlayer:input_type -> AirHockey.ReportRequest
+	44, // 62: AirHockey.AirHockeyService.ListReports:input_type -> AirHockey.ListReportsReq
+	47, // 63: AirHockey.AirHockeyService.ModeratePlayer:input_type -> AirHockey.ModerationRequest
+	27, // 64: AirHockey.AirHockeyService.ChangePassword:input_type -> AirHockey.ChangePasswordReq
+	82, // 65: AirHockey.AirHockeyService.ChangeDisplayName:input_type -> AirHockey.NewPlayerName
+	28, // 66: AirHockey.AirHockeyService.ChangeEmail:input_type -> AirHockey.ChangeEmailReq
+	33, // 67: AirHockey.AirHockeyService.DeleteAccount:input_type -> AirHockey.Account
+	31, // 68: AirHockey.AirHockeyService.RequestPasswordReset:input_type -> AirHockey.PasswordResetReq
+	32, // 69: AirHockey.AirHockeyService.ResetPassword:input_type -> AirHockey.ResetPasswordReq
+	76, // 70: AirHockey.AirHockeyService.NewRoom:input_type -> AirHockey.NewGameInfo
+	75, // 71: AirHockey.AirHockeyService.JoinRoom:input_type -> AirHockey.JoinRequest
+	36, // 72: AirHockey.AirHockeyService.InvitePlayer:input_type -> AirHockey.InviteRequest
+	38, // 73: AirHockey.AirHockeyService.RespondInvitation:input_type -> AirHockey.InvitationResponse
+	34, // 74: AirHockey.AirHockeyService.GameStream:input_type -> AirHockey.GameMessage
+	25, // 75: AirHockey.AirHockeyService.NewRecord:input_type -> AirHockey.Record
+	17, // 76: AirHockey.AirHockeyService.UpdateRankAndCash:input_type -> AirHockey.RankAndCash
+	4,  // 77: AirHockey.AirHockeyService.AddSkin:input_type -> AirHockey.AddNewSkin
+	18, // 78: AirHockey.AirHockeyService.GetTransactions:input_type -> AirHockey.TransactionsReq
+	55, // 79: AirHockey.AirHockeyService.SendGift:input_type -> AirHockey.SendGiftReq
+	58, // 80: AirHockey.AirHockeyService.RespondGift:input_type -> AirHockey.GiftResponse
+	59, // 81: AirHockey.AirHockeyService.ListGifts:input_type -> AirHockey.GiftsReq
+	49, // 82: AirHockey.AirHockeyService.GetAchievements:input_type -> AirHockey.AchievementsReq
+	52, // 83: AirHockey.AirHockeyService.GetMissions:input_type -> AirHockey.MissionsReq
+	54, // 84: AirHockey.AirHockeyService.ClaimMission:input_type -> AirHockey.ClaimMissionReq
+	21, // 85: AirHockey.AirHockeyService.GrantCash:input_type -> AirHockey.GrantCashReq
+	77, // 86: AirHockey.AirHockeyService.GetPlayerList:input_type -> AirHockey.RoomID
+	74, // 87: AirHockey.AirHockeyService.LeaveRoom:input_type -> AirHockey.LeaveRequest
+	74, // 88: AirHockey.AirHockeyService.Disconnect:input_type -> AirHockey.LeaveRequest
+	89, // 89: AirHockey.AirHockeyService.GetGlobalRecord:input_type -> AirHockey.Empty
+	87, // 90: AirHockey.AirHockeyService.GetSkinList:input_type -> AirHockey.PlayerID
+	5,  // 91: AirHockey.AirHockeyService.GetInventory:input_type -> AirHockey.InventoryReq
+	8,  // 92: AirHockey.AirHockeyService.GrantItem:input_type -> AirHockey.GrantItemReq
+	89, // 93: AirHockey.AirHockeyService.GetSkinCatalog:input_type -> AirHockey.Empty
+	15, // 94: AirHockey.AirHockeyService.PurchaseSkin:input_type -> AirHockey.PurchaseSkinReq
+	89, // 95: AirHockey.AirHockeyService.GetShop:input_type -> AirHockey.Empty
+	73, // 96: AirHockey.AirHockeyService.EquipSkin:input_type -> AirHockey.EquipSkinReq
+	89, // 97: AirHockey.AirHockeyService.NewAccount:output_type -> AirHockey.Empty
+	84, // 98: AirHockey.AirHockeyService.Login:output_type -> AirHockey.LoginPlayerInfo
+	84, // 99: AirHockey.AirHockeyService.LoginAsGuest:output_type -> AirHockey.LoginPlayerInfo
+	84, // 100: AirHockey.AirHockeyService.ClaimGuestAccount:output_type -> AirHockey.LoginPlayerInfo
+	89, // 101: AirHockey.AirHockeyService.Logout:output_type -> AirHockey.Empty
+	86, // 102: AirHockey.AirHockeyService.ClaimDailyReward:output_type -> AirHockey.DailyReward
+	89, // 103: AirHockey.AirHockeyService.SendFriendRequest:output_type -> AirHockey.Empty
+	89, // 104: AirHockey.AirHockeyService.AcceptFriendRequest:output_type -> AirHockey.Empty
+	89, // 105: AirHockey.AirHockeyService.DeclineFriendRequest:output_type -> AirHockey.Empty
+	89, // 106: AirHockey.AirHockeyService.RemoveFriend:output_type -> AirHockey.Empty
+	89, // 107: AirHockey.AirHockeyService.BlockPlayer:output_type -> AirHockey.Empty
+	89, // 108: AirHockey.AirHockeyService.UnblockPlayer:output_type -> AirHockey.Empty
+	68, // 109: AirHockey.AirHockeyService.GetFriendList:output_type -> AirHockey.FriendList
+	67, // 110: AirHockey.AirHockeyService.SubscribePresence:output_type -> AirHockey.PresenceUpdate
+	89, // 111: AirHockey.AirHockeyService.MutePlayer:output_type -> AirHockey.Empty
+	43, // 112: AirHockey.AirHockeyService.ReportPlayer:output_type -> AirHockey.ReportID
+	46, // 113: AirHockey.AirHockeyService.ListReports:output_type -> AirHockey.ReportList
+	89, // 114: AirHockey.AirHockeyService.ModeratePlayer:output_type -> AirHockey.Empty
+	89, // 115: AirHockey.AirHockeyService.ChangePassword:output_type -> AirHockey.Empty
+	89, // 116: AirHockey.AirHockeyService.ChangeDisplayName:output_type -> AirHockey.Empty
+	89, // 117: AirHockey.AirHockeyService.ChangeEmail:output_type -> AirHockey.Empty
+	89, // 118: AirHockey.AirHockeyService.DeleteAccount:output_type -> AirHockey.Empty
+	89, // 119: AirHockey.AirHockeyService.RequestPasswordReset:output_type -> AirHockey.Empty
+	89, // 120: AirHockey.AirHockeyService.ResetPassword:output_type -> AirHockey.Empty
+	77, // 121: AirHockey.AirHockeyService.NewRoom:output_type -> AirHockey.RoomID
+	77, // 122: AirHockey.AirHockeyService.JoinRoom:output_type -> AirHockey.RoomID
+	37, // 123: AirHockey.AirHockeyService.InvitePlayer:output_type -> AirHockey.Invitation
+	77, // 124: AirHockey.AirHockeyService.RespondInvitation:output_type -> AirHockey.RoomID
+	34, // 125: AirHockey.AirHockeyService.GameStream:output_type -> AirHockey.GameMessage
+	26, // 126: AirHockey.AirHockeyService.NewRecord:output_type -> AirHockey.RecordID
+	89, // 127: AirHockey.AirHockeyService.UpdateRankAndCash:output_type -> AirHockey.Empty
+	9,  // 128: AirHockey.AirHockeyService.AddSkin:output_type -> AirHockey.SkinList
+	20, // 129: AirHockey.AirHockeyService.GetTransactions:output_type -> AirHockey.TransactionList
+	56, // 130: AirHockey.AirHockeyService.SendGift:output_type -> AirHockey.GiftID
+	89, // 131: AirHockey.AirHockeyService.RespondGift:output_type -> AirHockey.Empty
+	60, // 132: AirHockey.AirHockeyService.ListGifts:output_type -> AirHockey.GiftList
+	50, // 133: AirHockey.AirHockeyService.GetAchievements:output_type -> AirHockey.AchievementList
+	53, // 134: AirHockey.AirHockeyService.GetMissions:output_type -> AirHockey.MissionList
+	22, // 135: AirHockey.AirHockeyService.ClaimMission:output_type -> AirHockey.CashBalance
+	22, // 136: AirHockey.AirHockeyService.GrantCash:output_type -> AirHockey.CashBalance
+	88, // 137: AirHockey.AirHockeyService.GetPlayerList:output_type -> AirHockey.PlayerList
+	89, // 138: AirHockey.AirHockeyService.LeaveRoom:output_type -> AirHockey.Empty
+	89, // 139: AirHockey.AirHockeyService.Disconnect:output_type -> AirHockey.Empty
+	23, // 140: AirHockey.AirHockeyService.GetGlobalRecord:output_type -> AirHockey.RankingList
+	9,  // 141: AirHockey.AirHockeyService.GetSkinList:output_type -> AirHockey.SkinList
+	7,  // 142: AirHockey.AirHockeyService.GetInventory:output_type -> AirHockey.Inventory
+	6,  // 143: AirHockey.AirHockeyService.GrantItem:output_type -> AirHockey.InventoryItem
+	14, // 144: AirHockey.AirHockeyService.GetSkinCatalog:output_type -> AirHockey.SkinCatalog
+	16, // 145: AirHockey.AirHockeyService.PurchaseSkin:output_type -> AirHockey.PurchaseResult
+	13, // 146: AirHockey.AirHockeyService.GetShop:output_type -> AirHockey.Shop
+	72, // 147: AirHockey.AirHockeyService.EquipSkin:output_type -> AirHockey.Loadout
+	97, // [97:148] is the sub-list for method output_type
+	46, // [46:97] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
+}
+
+func init() { file_pb_airHockey_proto_init() }
+func file_pb_airHockey_proto_init() {
+	if File_pb_airHockey_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pb_airHockey_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNewSkin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InventoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InventoryItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Inventory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkinList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogSkin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShopOffer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShopEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkinCatalog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurchaseSkinReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurchaseResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankAndCash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CashTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantCashReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CashBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RankingList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerRank); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Record); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeEmailReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimGuestReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordResetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetPasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvitationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvitationAnswer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Emote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReportsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Achievement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AchievementsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AchievementList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimMissionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendGiftReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Gift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModerationNotice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Friend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresenceUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Loadout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EquipSkinReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewGameInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Direction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyboardInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MouseInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewPlayerName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginPlayerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailyRewardReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailyReward); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_airHockey_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pb_airHockey_proto_msgTypes[30].OneofWrappers = []interface{}{
+		(*GameMessage_PlayerInput)(nil),
+		(*GameMessage_EntityState)(nil),
+		(*GameMessage_GameState)(nil),
+		(*GameMessage_Empty)(nil),
+		(*GameMessage_SessionEvent)(nil),
+		(*GameMessage_Invitation)(nil),
+		(*GameMessage_InvitationAnswer)(nil),
+		(*GameMessage_ChatMessage)(nil),
+		(*GameMessage_Emote)(nil),
+		(*GameMessage_ModerationNotice)(nil),
+		(*GameMessage_AchievementUnlocked)(nil),
+		(*GameMessage_Gift)(nil),
+	}
+	file_pb_airHockey_proto_msgTypes[75].OneofWrappers = []interface{}{
+		(*Direction_KeyboardInput)(nil),
+		(*Direction_MouseInput)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pb_airHockey_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   86,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pb_airHockey_proto_goTypes,
+		DependencyIndexes: file_pb_airHockey_proto_depIdxs,
+		EnumInfos:         file_pb_airHockey_proto_enumTypes,
+		MessageInfos:      file_pb_airHockey_proto_msgTypes,
+	}.Build()
+	File_pb_airHockey_proto = out.File
+	file_pb_airHockey_proto_rawDesc = nil
+	file_pb_airHockey_proto_goTypes = nil
+	file_pb_airHockey_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AirHockeyServiceClient is the client API for AirHockeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AirHockeyServiceClient interface {
+	// new account & login
+	NewAccount(ctx context.Context, in *NewAccountReq, opts ...grpc.CallOption) (*Empty, error)
+	Login(ctx context.Context, in *Account, opts ...grpc.CallOption) (*LoginPlayerInfo, error)
+	LoginAsGuest(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LoginPlayerInfo, error)
+	ClaimGuestAccount(ctx context.Context, in *ClaimGuestReq, opts ...grpc.CallOption) (*LoginPlayerInfo, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*Empty, error)
+	ClaimDailyReward(ctx context.Context, in *DailyRewardReq, opts ...grpc.CallOption) (*DailyReward, error)
+	// friends and block list, the acting player is identified by uuid + sessionToken
+	SendFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error)
+	AcceptFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeclineFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error)
+	RemoveFriend(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error)
+	BlockPlayer(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error)
+	UnblockPlayer(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetFriendList(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (*FriendList, error)
+	SubscribePresence(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (AirHockeyService_SubscribePresenceClient, error)
+	// chat is sent through GameStream, muting hides a player's messages from you
+	MutePlayer(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*Empty, error)
+	// reports from players, handled by admins (users with role "admin")
+	ReportPlayer(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportID, error)
+	ListReports(ctx context.Context, in *ListReportsReq, opts ...grpc.CallOption) (*ReportList, error)
+	ModeratePlayer(ctx context.Context, in *ModerationRequest, opts ...grpc.CallOption) (*Empty, error)
+	// account management
+	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*Empty, error)
+	ChangeDisplayName(ctx context.Context, in *NewPlayerName, opts ...grpc.CallOption) (*Empty, error)
+	ChangeEmail(ctx context.Context, in *ChangeEmailReq, opts ...grpc.CallOption) (*Empty, error)
+	DeleteAccount(ctx context.Context, in *Account, opts ...grpc.CallOption) (*Empty, error)
+	// password reset: a single-use token is mailed to the account email
+	RequestPasswordReset(ctx context.Context, in *PasswordResetReq, opts ...grpc.CallOption) (*Empty, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*Empty, error)
+	// host and player in room + start -> play game
+	NewRoom(ctx context.Context, in *NewGameInfo, opts ...grpc.CallOption) (*RoomID, error)
+	JoinRoom(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*RoomID, error)
+	InvitePlayer(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*Invitation, error)
+	RespondInvitation(ctx context.Context, in *InvitationResponse, opts ...grpc.CallOption) (*RoomID, error)
+	// in game
+	GameStream(ctx context.Context, opts ...grpc.CallOption) (AirHockeyService_GameStreamClient, error)
+	NewRecord(ctx context.Context, in *Record, opts ...grpc.CallOption) (*RecordID, error)
+	UpdateRankAndCash(ctx context.Context, in *RankAndCash, opts ...grpc.CallOption) (*Empty, error)
+	AddSkin(ctx context.Context, in *AddNewSkin, opts ...grpc.CallOption) (*SkinList, error)
+	GetTransactions(ctx context.Context, in *TransactionsReq, opts ...grpc.CallOption) (*TransactionList, error)
+	SendGift(ctx context.Context, in *SendGiftReq, opts ...grpc.CallOption) (*GiftID, error)
+	RespondGift(ctx context.Context, in *GiftResponse, opts ...grpc.CallOption) (*Empty, error)
+	ListGifts(ctx context.Context, in *GiftsReq, opts ...grpc.CallOption) (*GiftList, error)
+	GetAchievements(ctx context.Context, in *AchievementsReq, opts ...grpc.CallOption) (*AchievementList, error)
+	GetMissions(ctx context.Context, in *MissionsReq, opts ...grpc.CallOption) (*MissionList, error)
+	ClaimMission(ctx context.Context, in *ClaimMissionReq, opts ...grpc.CallOption) (*CashBalance, error)
+	GrantCash(ctx context.Context, in *GrantCashReq, opts ...grpc.CallOption) (*CashBalance, error)
+	// optional functions for clients
+	GetPlayerList(ctx context.Context, in *RoomID, opts ...grpc.CallOption) (*PlayerList, error)
+	LeaveRoom(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*Empty, error)
+	Disconnect(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetGlobalRecord(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RankingList, error)
+	GetSkinList(ctx context.Context, in *PlayerID, opts ...grpc.CallOption) (*SkinList, error)
+	GetInventory(ctx context.Context, in *InventoryReq, opts ...grpc.CallOption) (*Inventory, error)
+	GrantItem(ctx context.Context, in *GrantItemReq, opts ...grpc.CallOption) (*InventoryItem, error)
+	GetSkinCatalog(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SkinCatalog, error)
+	PurchaseSkin(ctx context.Context, in *PurchaseSkinReq, opts ...grpc.CallOption) (*PurchaseResult, error)
+	GetShop(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Shop, error)
+	EquipSkin(ctx context.Context, in *EquipSkinReq, opts ...grpc.CallOption) (*Loadout, error)
+}
+
+type airHockeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAirHockeyServiceClient(cc grpc.ClientConnInterface) AirHockeyServiceClient {
+	return &airHockeyServiceClient{cc}
+}
+
+func (c *airHockeyServiceClient) NewAccount(ctx context.Context, in *NewAccountReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/NewAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) Login(ctx context.Context, in *Account, opts ...grpc.CallOption) (*LoginPlayerInfo, error) {
+	out := new(LoginPlayerInfo)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) LoginAsGuest(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*LoginPlayerInfo, error) {
+	out := new(LoginPlayerInfo)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/LoginAsGuest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) ClaimGuestAccount(ctx context.Context, in *ClaimGuestReq, opts ...grpc.CallOption) (*LoginPlayerInfo, error) {
+	out := new(LoginPlayerInfo)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/ClaimGuestAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) ClaimDailyReward(ctx context.Context, in *DailyRewardReq, opts ...grpc.CallOption) (*DailyReward, error) {
+	out := new(DailyReward)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/ClaimDailyReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) SendFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/SendFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) AcceptFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/AcceptFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) DeclineFriendRequest(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/DeclineFriendRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) RemoveFriend(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/RemoveFriend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) BlockPlayer(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/BlockPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) UnblockPlayer(ctx context.Context, in *FriendRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/UnblockPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GetFriendList(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (*FriendList, error) {
+	out := new(FriendList)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetFriendList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) SubscribePresence(ctx context.Context, in *SessionReq, opts ...grpc.CallOption) (AirHockeyService_SubscribePresenceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AirHockeyService_serviceDesc.Streams[0], "/AirHockey.AirHockeyService/SubscribePresence", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &airHockeyServiceSubscribePresenceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AirHockeyService_SubscribePresenceClient interface {
+	Recv() (*PresenceUpdate, error)
+	grpc.ClientStream
+}
+
+type airHockeyServiceSubscribePresenceClient struct {
+	grpc.ClientStream
+}
+
+func (x *airHockeyServiceSubscribePresenceClient) Recv() (*PresenceUpdate, error) {
+	m := new(PresenceUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *airHockeyServiceClient) MutePlayer(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/MutePlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) ReportPlayer(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportID, error) {
+	out := new(ReportID)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/ReportPlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) ListReports(ctx context.Context, in *ListReportsReq, opts ...grpc.CallOption) (*ReportList, error) {
+	out := new(ReportList)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/ListReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) ModeratePlayer(ctx context.Context, in *ModerationRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/ModeratePlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) ChangeDisplayName(ctx context.Context, in *NewPlayerName, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/ChangeDisplayName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) ChangeEmail(ctx context.Context, in *ChangeEmailReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/ChangeEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) DeleteAccount(ctx context.Context, in *Account, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) RequestPasswordReset(ctx context.Context, in *PasswordResetReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) NewRoom(ctx context.Context, in *NewGameInfo, opts ...grpc.CallOption) (*RoomID, error) {
+	out := new(RoomID)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/NewRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) JoinRoom(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*RoomID, error) {
+	out := new(RoomID)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/JoinRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) InvitePlayer(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/InvitePlayer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) RespondInvitation(ctx context.Context, in *InvitationResponse, opts ...grpc.CallOption) (*RoomID, error) {
+	out := new(RoomID)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/RespondInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GameStream(ctx context.Context, opts ...grpc.CallOption) (AirHockeyService_GameStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AirHockeyService_serviceDesc.Streams[1], "/AirHockey.AirHockeyService/GameStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &airHockeyServiceGameStreamClient{stream}
+	return x, nil
+}
+
+type AirHockeyService_GameStreamClient interface {
+	Send(*GameMessage) error
+	Recv() (*GameMessage, error)
+	grpc.ClientStream
+}
+
+type airHockeyServiceGameStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *airHockeyServiceGameStreamClient) Send(m *GameMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *airHockeyServiceGameStreamClient) Recv() (*GameMessage, error) {
+	m := new(GameMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *airHockeyServiceClient) NewRecord(ctx context.Context, in *Record, opts ...grpc.CallOption) (*RecordID, error) {
+	out := new(RecordID)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/NewRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) UpdateRankAndCash(ctx context.Context, in *RankAndCash, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/UpdateRankAndCash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) AddSkin(ctx context.Context, in *AddNewSkin, opts ...grpc.CallOption) (*SkinList, error) {
+	out := new(SkinList)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/AddSkin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GetTransactions(ctx context.Context, in *TransactionsReq, opts ...grpc.CallOption) (*TransactionList, error) {
+	out := new(TransactionList)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) SendGift(ctx context.Context, in *SendGiftReq, opts ...grpc.CallOption) (*GiftID, error) {
+	out := new(GiftID)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/SendGift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) RespondGift(ctx context.Context, in *GiftResponse, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/RespondGift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) ListGifts(ctx context.Context, in *GiftsReq, opts ...grpc.CallOption) (*GiftList, error) {
+	out := new(GiftList)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/ListGifts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GetAchievements(ctx context.Context, in *AchievementsReq, opts ...grpc.CallOption) (*AchievementList, error) {
+	out := new(AchievementList)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetAchievements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GetMissions(ctx context.Context, in *MissionsReq, opts ...grpc.CallOption) (*MissionList, error) {
+	out := new(MissionList)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetMissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) ClaimMission(ctx context.Context, in *ClaimMissionReq, opts ...grpc.CallOption) (*CashBalance, error) {
+	out := new(CashBalance)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/ClaimMission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GrantCash(ctx context.Context, in *GrantCashReq, opts ...grpc.CallOption) (*CashBalance, error) {
+	out := new(CashBalance)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GrantCash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GetPlayerList(ctx context.Context, in *RoomID, opts ...grpc.CallOption) (*PlayerList, error) {
+	out := new(PlayerList)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetPlayerList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) LeaveRoom(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/LeaveRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) Disconnect(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/Disconnect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GetGlobalRecord(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RankingList, error) {
+	out := new(RankingList)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetGlobalRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GetSkinList(ctx context.Context, in *PlayerID, opts ...grpc.CallOption) (*SkinList, error) {
+	out := new(SkinList)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetSkinList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GetInventory(ctx context.Context, in *InventoryReq, opts ...grpc.CallOption) (*Inventory, error) {
+	out := new(Inventory)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GrantItem(ctx context.Context, in *GrantItemReq, opts ...grpc.CallOption) (*InventoryItem, error) {
+	out := new(InventoryItem)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GrantItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GetSkinCatalog(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SkinCatalog, error) {
+	out := new(SkinCatalog)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetSkinCatalog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) PurchaseSkin(ctx context.Context, in *PurchaseSkinReq, opts ...grpc.CallOption) (*PurchaseResult, error) {
+	out := new(PurchaseResult)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/PurchaseSkin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) GetShop(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Shop, error) {
+	out := new(Shop)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetShop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *airHockeyServiceClient) EquipSkin(ctx context.Context, in *EquipSkinReq, opts ...grpc.CallOption) (*Loadout, error) {
+	out := new(Loadout)
+	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/EquipSkin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AirHockeyServiceServer is the server API for AirHockeyService service.
+type AirHockeyServiceServer interface {
+	// new account & login
+	NewAccount(context.Context, *NewAccountReq) (*Empty, error)
+	Login(context.Context, *Account) (*LoginPlayerInfo, error)
+	LoginAsGuest(context.Context, *Empty) (*LoginPlayerInfo, error)
+	ClaimGuestAccount(context.Context, *ClaimGuestReq) (*LoginPlayerInfo, error)
+	Logout(context.Context, *LogoutRequest) (*Empty, error)
+	ClaimDailyReward(context.Context, *DailyRewardReq) (*DailyReward, error)
+	// friends and block list, the acting player is identified by uuid + sessionToken
+	SendFriendRequest(context.Context, *FriendRequest) (*Empty, error)
+	AcceptFriendRequest(context.Context, *FriendRequest) (*Empty, error)
+	DeclineFriendRequest(context.Context, *FriendRequest) (*Empty, error)
+	RemoveFriend(context.Context, *FriendRequest) (*Empty, error)
+	BlockPlayer(context.Context, *FriendRequest) (*Empty, error)
+	UnblockPlayer(context.Context, *FriendRequest) (*Empty, error)
+	GetFriendList(context.Context, *SessionReq) (*FriendList, error)
+	SubscribePresence(*SessionReq, AirHockeyService_SubscribePresenceServer) error
+	// chat is sent through GameStream, muting hides a player's messages from you
+	MutePlayer(context.Context, *MuteRequest) (*Empty, error)
+	// reports from players, handled by admins (users with role "admin")
+	ReportPlayer(context.Context, *ReportRequest) (*ReportID, error)
+	ListReports(context.Context, *ListReportsReq) (*ReportList, error)
+	ModeratePlayer(context.Context, *ModerationRequest) (*Empty, error)
+	// account management
+	ChangePassword(context.Context, *ChangePasswordReq) (*Empty, error)
+	ChangeDisplayName(context.Context, *NewPlayerName) (*Empty, error)
+	ChangeEmail(context.Context, *ChangeEmailReq) (*Empty, error)
+	DeleteAccount(context.Context, *Account) (*Empty, error)
+	// password reset: a single-use token is mailed to the account email
+	RequestPasswordReset(context.Context, *PasswordResetReq) (*Empty, error)
+	ResetPassword(context.Context, *ResetPasswordReq) (*Empty, error)
+	// host and player in room + start -> play game
+	NewRoom(context.Context, *NewGameInfo) (*RoomID, error)
+	JoinRoom(context.Context, *JoinRequest) (*RoomID, error)
+	InvitePlayer(context.Context, *InviteRequest) (*Invitation, error)
+	RespondInvitation(context.Context, *InvitationResponse) (*RoomID, error)
+	// in game
+	GameStream(AirHockeyService_GameStreamServer) error
+	NewRecord(context.Context, *Record) (*RecordID, error)
+	UpdateRankAndCash(context.Context, *RankAndCash) (*Empty, error)
+	AddSkin(context.Context, *AddNewSkin) (*SkinList, error)
+	GetTransactions(context.Context, *TransactionsReq) (*TransactionList, error)
+	SendGift(context.Context, *SendGiftReq) (*GiftID, error)
+	RespondGift(context.Context, *GiftResponse) (*Empty, error)
+	ListGifts(context.Context, *GiftsReq) (*GiftList, error)
+	GetAchievements(context.Context, *AchievementsReq) (*AchievementList, error)
+	GetMissions(context.Context, *MissionsReq) (*MissionList, error)
+	ClaimMission(context.Context, *ClaimMissionReq) (*CashBalance, error)
+	GrantCash(context.Context, *GrantCashReq) (*CashBalance, error)
+	// optional functions for clients
+	GetPlayerList(context.Context, *RoomID) (*PlayerList, error)
+	LeaveRoom(context.Context, *LeaveRequest) (*Empty, error)
+	Disconnect(context.Context, *LeaveRequest) (*Empty, error)
+	GetGlobalRecord(context.Context, *Empty) (*RankingList, error)
+	GetSkinList(context.Context, *PlayerID) (*SkinList, error)
+	GetInventory(context.Context, *InventoryReq) (*Inventory, error)
+	GrantItem(context.Context, *GrantItemReq) (*InventoryItem, error)
+	GetSkinCatalog(context.Context, *Empty) (*SkinCatalog, error)
+	PurchaseSkin(context.Context, *PurchaseSkinReq) (*PurchaseResult, error)
+	GetShop(context.Context, *Empty) (*Shop, error)
+	EquipSkin(context.Context, *EquipSkinReq) (*Loadout, error)
+}
+
+// UnimplementedAirHockeyServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAirHockeyServiceServer struct {
+}
+
+func (*UnimplementedAirHockeyServiceServer) NewAccount(context.Context, *NewAccountReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewAccount not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) Login(context.Context, *Account) (*LoginPlayerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) LoginAsGuest(context.Context, *Empty) (*LoginPlayerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginAsGuest not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) ClaimGuestAccount(context.Context, *ClaimGuestReq) (*LoginPlayerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimGuestAccount not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) Logout(context.Context, *LogoutRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) ClaimDailyReward(context.Context, *DailyRewardReq) (*DailyReward, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDailyReward not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) SendFriendRequest(context.Context, *FriendRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFriendRequest not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) AcceptFriendRequest(context.Context, *FriendRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendRequest not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) DeclineFriendRequest(context.Context, *FriendRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineFriendRequest not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) RemoveFriend(context.Context, *FriendRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriend not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) BlockPlayer(context.Context, *FriendRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockPlayer not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) UnblockPlayer(context.Context, *FriendRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockPlayer not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GetFriendList(context.Context, *SessionReq) (*FriendList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendList not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) SubscribePresence(*SessionReq, AirHockeyService_SubscribePresenceServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePresence not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) MutePlayer(context.Context, *MuteRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MutePlayer not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) ReportPlayer(context.Context, *ReportRequest) (*ReportID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPlayer not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) ListReports(context.Context, *ListReportsReq) (*ReportList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) ModeratePlayer(context.Context, *ModerationRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModeratePlayer not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) ChangePassword(context.Context, *ChangePasswordReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) ChangeDisplayName(context.Context, *NewPlayerName) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeDisplayName not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) ChangeEmail(context.Context, *ChangeEmailReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmail not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) DeleteAccount(context.Context, *Account) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) RequestPasswordReset(context.Context, *PasswordResetReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) ResetPassword(context.Context, *ResetPasswordReq) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) NewRoom(context.Context, *NewGameInfo) (*RoomID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewRoom not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) JoinRoom(context.Context, *JoinRequest) (*RoomID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinRoom not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) InvitePlayer(context.Context, *InviteRequest) (*Invitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvitePlayer not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) RespondInvitation(context.Context, *InvitationResponse) (*RoomID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondInvitation not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GameStream(AirHockeyService_GameStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GameStream not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) NewRecord(context.Context, *Record) (*RecordID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewRecord not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) UpdateRankAndCash(context.Context, *RankAndCash) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRankAndCash not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) AddSkin(context.Context, *AddNewSkin) (*SkinList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSkin not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GetTransactions(context.Context, *TransactionsReq) (*TransactionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) SendGift(context.Context, *SendGiftReq) (*GiftID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGift not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) RespondGift(context.Context, *GiftResponse) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondGift not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) ListGifts(context.Context, *GiftsReq) (*GiftList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGifts not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GetAchievements(context.Context, *AchievementsReq) (*AchievementList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAchievements not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GetMissions(context.Context, *MissionsReq) (*MissionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMissions not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) ClaimMission(context.Context, *ClaimMissionReq) (*CashBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimMission not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GrantCash(context.Context, *GrantCashReq) (*CashBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantCash not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GetPlayerList(context.Context, *RoomID) (*PlayerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerList not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) LeaveRoom(context.Context, *LeaveRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveRoom not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) Disconnect(context.Context, *LeaveRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GetGlobalRecord(context.Context, *Empty) (*RankingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGlobalRecord not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GetSkinList(context.Context, *PlayerID) (*SkinList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSkinList not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GetInventory(context.Context, *InventoryReq) (*Inventory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventory not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GrantItem(context.Context, *GrantItemReq) (*InventoryItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantItem not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GetSkinCatalog(context.Context, *Empty) (*SkinCatalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSkinCatalog not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) PurchaseSkin(context.Context, *PurchaseSkinReq) (*PurchaseResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseSkin not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) GetShop(context.Context, *Empty) (*Shop, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShop not implemented")
+}
+func (*UnimplementedAirHockeyServiceServer) EquipSkin(context.Context, *EquipSkinReq) (*Loadout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquipSkin not implemented")
+}
+
+func RegisterAirHockeyServiceServer(s *grpc.Server, srv AirHockeyServiceServer) {
+	s.RegisterService(&_AirHockeyService_serviceDesc, srv)
+}
+
+func _AirHockeyService_NewAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).NewAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/NewAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).NewAccount(ctx, req.(*NewAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Account)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/Login",
 	}
-	file_pb_airHockey_proto_msgTypes[9].OneofWrappers = []interface{}{
-		(*GameMessage_PlayerInput)(nil),
-		(*GameMessage_EntityState)(nil),
-		(*GameMessage_GameState)(nil),
-		(*GameMessage_Empty)(nil),
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).Login(ctx, req.(*Account))
 	}
-	file_pb_airHockey_proto_msgTypes[18].OneofWrappers = []interface{}{
-		(*Direction_KeyboardInput)(nil),
-		(*Direction_MouseInput)(nil),
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_LoginAsGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_pb_airHockey_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   27,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_pb_airHockey_proto_goTypes,
-		DependencyIndexes: file_pb_airHockey_proto_depIdxs,
-		MessageInfos:      file_pb_airHockey_proto_msgTypes,
-	}.Build()
-	File_pb_airHockey_proto = out.File
-	file_pb_airHockey_proto_rawDesc = nil
-	file_pb_airHockey_proto_goTypes = nil
-	file_pb_airHockey_proto_depIdxs = nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).LoginAsGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/LoginAsGuest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).LoginAsGuest(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func _AirHockeyService_ClaimGuestAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimGuestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).ClaimGuestAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/ClaimGuestAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).ClaimGuestAccount(ctx, req.(*ClaimGuestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+func _AirHockeyService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// AirHockeyServiceClient is the client API for AirHockeyService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AirHockeyServiceClient interface {
-	// new account & login
-	NewAccount(ctx context.Context, in *NewAccountReq, opts ...grpc.CallOption) (*Empty, error)
-	Login(ctx context.Context, in *Account, opts ...grpc.CallOption) (*LoginPlayerInfo, error)
-	// host and player in room + start -> play game
-	NewRoom(ctx context.Context, in *NewGameInfo, opts ...grpc.CallOption) (*RoomID, error)
-	JoinRoom(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*RoomID, error)
-	// in game
-	GameStream(ctx context.Context, opts ...grpc.CallOption) (AirHockeyService_GameStreamClient, error)
-	NewRecord(ctx context.Context, in *Record, opts ...grpc.CallOption) (*RecordID, error)
-	UpdateRankAndCash(ctx context.Context, in *RankAndCash, opts ...grpc.CallOption) (*Empty, error)
-	AddSkin(ctx context.Context, in *AddNewSkin, opts ...grpc.CallOption) (*SkinList, error)
-	// optional functions for clients
-	GetPlayerList(ctx context.Context, in *RoomID, opts ...grpc.CallOption) (*PlayerList, error)
-	LeaveRoom(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*Empty, error)
-	Disconnect(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*Empty, error)
-	GetGlobalRecord(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RankingList, error)
-	GetSkinList(ctx context.Context, in *PlayerID, opts ...grpc.CallOption) (*SkinList, error)
+func _AirHockeyService_ClaimDailyReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DailyRewardReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).ClaimDailyReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/ClaimDailyReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).ClaimDailyReward(ctx, req.(*DailyRewardReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type airHockeyServiceClient struct {
-	cc grpc.ClientConnInterface
+func _AirHockeyService_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).SendFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/SendFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).SendFriendRequest(ctx, req.(*FriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewAirHockeyServiceClient(cc grpc.ClientConnInterface) AirHockeyServiceClient {
-	return &airHockeyServiceClient{cc}
+func _AirHockeyService_AcceptFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).AcceptFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/AcceptFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).AcceptFriendRequest(ctx, req.(*FriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) NewAccount(ctx context.Context, in *NewAccountReq, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/NewAccount", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_DeclineFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).DeclineFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/DeclineFriendRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).DeclineFriendRequest(ctx, req.(*FriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) Login(ctx context.Context, in *Account, opts ...grpc.CallOption) (*LoginPlayerInfo, error) {
-	out := new(LoginPlayerInfo)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/Login", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).RemoveFriend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/RemoveFriend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).RemoveFriend(ctx, req.(*FriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) NewRoom(ctx context.Context, in *NewGameInfo, opts ...grpc.CallOption) (*RoomID, error) {
-	out := new(RoomID)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/NewRoom", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_BlockPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).BlockPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/BlockPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).BlockPlayer(ctx, req.(*FriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) JoinRoom(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*RoomID, error) {
-	out := new(RoomID)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/JoinRoom", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_UnblockPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FriendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).UnblockPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/UnblockPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).UnblockPlayer(ctx, req.(*FriendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_GetFriendList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).GetFriendList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/GetFriendList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).GetFriendList(ctx, req.(*SessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_SubscribePresence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SessionReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AirHockeyServiceServer).SubscribePresence(m, &airHockeyServiceSubscribePresenceServer{stream})
+}
+
+type AirHockeyService_SubscribePresenceServer interface {
+	Send(*PresenceUpdate) error
+	grpc.ServerStream
+}
+
+type airHockeyServiceSubscribePresenceServer struct {
+	grpc.ServerStream
+}
+
+func (x *airHockeyServiceSubscribePresenceServer) Send(m *PresenceUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AirHockeyService_MutePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).MutePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/MutePlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).MutePlayer(ctx, req.(*MuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_ReportPlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).ReportPlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/ReportPlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).ReportPlayer(ctx, req.(*ReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/ListReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).ListReports(ctx, req.(*ListReportsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_ModeratePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).ModeratePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/ModeratePlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).ModeratePlayer(ctx, req.(*ModerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) GameStream(ctx context.Context, opts ...grpc.CallOption) (AirHockeyService_GameStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_AirHockeyService_serviceDesc.Streams[0], "/AirHockey.AirHockeyService/GameStream", opts...)
-	if err != nil {
+func _AirHockeyService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &airHockeyServiceGameStreamClient{stream}
-	return x, nil
-}
-
-type AirHockeyService_GameStreamClient interface {
-	Send(*GameMessage) error
-	Recv() (*GameMessage, error)
-	grpc.ClientStream
-}
-
-type airHockeyServiceGameStreamClient struct {
-	grpc.ClientStream
-}
-
-func (x *airHockeyServiceGameStreamClient) Send(m *GameMessage) error {
-	return x.ClientStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *airHockeyServiceGameStreamClient) Recv() (*GameMessage, error) {
-	m := new(GameMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _AirHockeyService_ChangeDisplayName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewPlayerName)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).ChangeDisplayName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/ChangeDisplayName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).ChangeDisplayName(ctx, req.(*NewPlayerName))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) NewRecord(ctx context.Context, in *Record, opts ...grpc.CallOption) (*RecordID, error) {
-	out := new(RecordID)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/NewRecord", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_ChangeEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeEmailReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).ChangeEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/ChangeEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).ChangeEmail(ctx, req.(*ChangeEmailReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) UpdateRankAndCash(ctx context.Context, in *RankAndCash, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/UpdateRankAndCash", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Account)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).DeleteAccount(ctx, req.(*Account))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) AddSkin(ctx context.Context, in *AddNewSkin, opts ...grpc.CallOption) (*SkinList, error) {
-	out := new(SkinList)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/AddSkin", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordResetReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).RequestPasswordReset(ctx, req.(*PasswordResetReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) GetPlayerList(ctx context.Context, in *RoomID, opts ...grpc.CallOption) (*PlayerList, error) {
-	out := new(PlayerList)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetPlayerList", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) LeaveRoom(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/LeaveRoom", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_NewRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewGameInfo)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).NewRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/NewRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).NewRoom(ctx, req.(*NewGameInfo))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) Disconnect(ctx context.Context, in *LeaveRequest, opts ...grpc.CallOption) (*Empty, error) {
-	out := new(Empty)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/Disconnect", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_JoinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).JoinRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/JoinRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).JoinRoom(ctx, req.(*JoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) GetGlobalRecord(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RankingList, error) {
-	out := new(RankingList)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetGlobalRecord", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_InvitePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).InvitePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/InvitePlayer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).InvitePlayer(ctx, req.(*InviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *airHockeyServiceClient) GetSkinList(ctx context.Context, in *PlayerID, opts ...grpc.CallOption) (*SkinList, error) {
-	out := new(SkinList)
-	err := c.cc.Invoke(ctx, "/AirHockey.AirHockeyService/GetSkinList", in, out, opts...)
-	if err != nil {
+func _AirHockeyService_RespondInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvitationResponse)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).RespondInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/RespondInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).RespondInvitation(ctx, req.(*InvitationResponse))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// AirHockeyServiceServer is the server API for AirHockeyService service.
-type AirHockeyServiceServer interface {
-	// new account & login
-	NewAccount(context.Context, *NewAccountReq) (*Empty, error)
-	Login(context.Context, *Account) (*LoginPlayerInfo, error)
-	// host and player in room + start -> play game
-	NewRoom(context.Context, *NewGameInfo) (*RoomID, error)
-	JoinRoom(context.Context, *JoinRequest) (*RoomID, error)
-	// in game
-	GameStream(AirHockeyService_GameStreamServer) error
-	NewRecord(context.Context, *Record) (*RecordID, error)
-	UpdateRankAndCash(context.Context, *RankAndCash) (*Empty, error)
-	AddSkin(context.Context, *AddNewSkin) (*SkinList, error)
-	// optional functions for clients
-	GetPlayerList(context.Context, *RoomID) (*PlayerList, error)
-	LeaveRoom(context.Context, *LeaveRequest) (*Empty, error)
-	Disconnect(context.Context, *LeaveRequest) (*Empty, error)
-	GetGlobalRecord(context.Context, *Empty) (*RankingList, error)
-	GetSkinList(context.Context, *PlayerID) (*SkinList, error)
+func _AirHockeyService_GameStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AirHockeyServiceServer).GameStream(&airHockeyServiceGameStreamServer{stream})
 }
 
-// UnimplementedAirHockeyServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAirHockeyServiceServer struct {
+type AirHockeyService_GameStreamServer interface {
+	Send(*GameMessage) error
+	Recv() (*GameMessage, error)
+	grpc.ServerStream
 }
 
-func (*UnimplementedAirHockeyServiceServer) NewAccount(context.Context, *NewAccountReq) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewAccount not implemented")
-}
-func (*UnimplementedAirHockeyServiceServer) Login(context.Context, *Account) (*LoginPlayerInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
-}
-func (*UnimplementedAirHockeyServiceServer) NewRoom(context.Context, *NewGameInfo) (*RoomID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewRoom not implemented")
-}
-func (*UnimplementedAirHockeyServiceServer) JoinRoom(context.Context, *JoinRequest) (*RoomID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinRoom not implemented")
-}
-func (*UnimplementedAirHockeyServiceServer) GameStream(AirHockeyService_GameStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GameStream not implemented")
-}
-func (*UnimplementedAirHockeyServiceServer) NewRecord(context.Context, *Record) (*RecordID, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NewRecord not implemented")
-}
-func (*UnimplementedAirHockeyServiceServer) UpdateRankAndCash(context.Context, *RankAndCash) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRankAndCash not implemented")
-}
-func (*UnimplementedAirHockeyServiceServer) AddSkin(context.Context, *AddNewSkin) (*SkinList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSkin not implemented")
-}
-func (*UnimplementedAirHockeyServiceServer) GetPlayerList(context.Context, *RoomID) (*PlayerList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerList not implemented")
-}
-func (*UnimplementedAirHockeyServiceServer) LeaveRoom(context.Context, *LeaveRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LeaveRoom not implemented")
-}
-func (*UnimplementedAirHockeyServiceServer) Disconnect(context.Context, *LeaveRequest) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+type airHockeyServiceGameStreamServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedAirHockeyServiceServer) GetGlobalRecord(context.Context, *Empty) (*RankingList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGlobalRecord not implemented")
+
+func (x *airHockeyServiceGameStreamServer) Send(m *GameMessage) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedAirHockeyServiceServer) GetSkinList(context.Context, *PlayerID) (*SkinList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSkinList not implemented")
+
+func (x *airHockeyServiceGameStreamServer) Recv() (*GameMessage, error) {
+	m := new(GameMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func RegisterAirHockeyServiceServer(s *grpc.Server, srv AirHockeyServiceServer) {
-	s.RegisterService(&_AirHockeyService_serviceDesc, srv)
+func _AirHockeyService_NewRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Record)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).NewRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/NewRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).NewRecord(ctx, req.(*Record))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AirHockeyService_NewAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewAccountReq)
+func _AirHockeyService_UpdateRankAndCash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RankAndCash)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AirHockeyServiceServer).NewAccount(ctx, in)
+		return srv.(AirHockeyServiceServer).UpdateRankAndCash(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/AirHockey.AirHockeyService/NewAccount",
+		FullMethod: "/AirHockey.AirHockeyService/UpdateRankAndCash",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AirHockeyServiceServer).NewAccount(ctx, req.(*NewAccountReq))
+		return srv.(AirHockeyServiceServer).UpdateRankAndCash(ctx, req.(*RankAndCash))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AirHockeyService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Account)
+func _AirHockeyService_AddSkin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNewSkin)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AirHockeyServiceServer).Login(ctx, in)
+		return srv.(AirHockeyServiceServer).AddSkin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/AirHockey.AirHockeyService/Login",
+		FullMethod: "/AirHockey.AirHockeyService/AddSkin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AirHockeyServiceServer).Login(ctx, req.(*Account))
+		return srv.(AirHockeyServiceServer).AddSkin(ctx, req.(*AddNewSkin))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AirHockeyService_NewRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewGameInfo)
+func _AirHockeyService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AirHockeyServiceServer).NewRoom(ctx, in)
+		return srv.(AirHockeyServiceServer).GetTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/AirHockey.AirHockeyService/NewRoom",
+		FullMethod: "/AirHockey.AirHockeyService/GetTransactions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AirHockeyServiceServer).NewRoom(ctx, req.(*NewGameInfo))
+		return srv.(AirHockeyServiceServer).GetTransactions(ctx, req.(*TransactionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AirHockeyService_JoinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinRequest)
+func _AirHockeyService_SendGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendGiftReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AirHockeyServiceServer).JoinRoom(ctx, in)
+		return srv.(AirHockeyServiceServer).SendGift(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/AirHockey.AirHockeyService/JoinRoom",
+		FullMethod: "/AirHockey.AirHockeyService/SendGift",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AirHockeyServiceServer).JoinRoom(ctx, req.(*JoinRequest))
+		return srv.(AirHockeyServiceServer).SendGift(ctx, req.(*SendGiftReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AirHockeyService_GameStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(AirHockeyServiceServer).GameStream(&airHockeyServiceGameStreamServer{stream})
-}
-
-type AirHockeyService_GameStreamServer interface {
-	Send(*GameMessage) error
-	Recv() (*GameMessage, error)
-	grpc.ServerStream
-}
-
-type airHockeyServiceGameStreamServer struct {
-	grpc.ServerStream
+func _AirHockeyService_RespondGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GiftResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).RespondGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/RespondGift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).RespondGift(ctx, req.(*GiftResponse))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *airHockeyServiceGameStreamServer) Send(m *GameMessage) error {
-	return x.ServerStream.SendMsg(m)
+func _AirHockeyService_ListGifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GiftsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).ListGifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/ListGifts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).ListGifts(ctx, req.(*GiftsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *airHockeyServiceGameStreamServer) Recv() (*GameMessage, error) {
-	m := new(GameMessage)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _AirHockeyService_GetAchievements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AchievementsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).GetAchievements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/GetAchievements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).GetAchievements(ctx, req.(*AchievementsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AirHockeyService_NewRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Record)
+func _AirHockeyService_GetMissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MissionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AirHockeyServiceServer).NewRecord(ctx, in)
+		return srv.(AirHockeyServiceServer).GetMissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/AirHockey.AirHockeyService/NewRecord",
+		FullMethod: "/AirHockey.AirHockeyService/GetMissions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AirHockeyServiceServer).NewRecord(ctx, req.(*Record))
+		return srv.(AirHockeyServiceServer).GetMissions(ctx, req.(*MissionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AirHockeyService_UpdateRankAndCash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RankAndCash)
+func _AirHockeyService_ClaimMission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimMissionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AirHockeyServiceServer).UpdateRankAndCash(ctx, in)
+		return srv.(AirHockeyServiceServer).ClaimMission(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/AirHockey.AirHockeyService/UpdateRankAndCash",
+		FullMethod: "/AirHockey.AirHockeyService/ClaimMission",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AirHockeyServiceServer).UpdateRankAndCash(ctx, req.(*RankAndCash))
+		return srv.(AirHockeyServiceServer).ClaimMission(ctx, req.(*ClaimMissionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AirHockeyService_AddSkin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddNewSkin)
+func _AirHockeyService_GrantCash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantCashReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AirHockeyServiceServer).AddSkin(ctx, in)
+		return srv.(AirHockeyServiceServer).GrantCash(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/AirHockey.AirHockeyService/AddSkin",
+		FullMethod: "/AirHockey.AirHockeyService/GrantCash",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AirHockeyServiceServer).AddSkin(ctx, req.(*AddNewSkin))
+		return srv.(AirHockeyServiceServer).GrantCash(ctx, req.(*GrantCashReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2850,6 +10005,114 @@ func _AirHockeyService_GetSkinList_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AirHockeyService_GetInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InventoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).GetInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/GetInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).GetInventory(ctx, req.(*InventoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_GrantItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).GrantItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/GrantItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).GrantItem(ctx, req.(*GrantItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_GetSkinCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).GetSkinCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/GetSkinCatalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).GetSkinCatalog(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_PurchaseSkin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseSkinReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).PurchaseSkin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/PurchaseSkin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).PurchaseSkin(ctx, req.(*PurchaseSkinReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_GetShop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).GetShop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/GetShop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).GetShop(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AirHockeyService_EquipSkin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EquipSkinReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AirHockeyServiceServer).EquipSkin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/AirHockey.AirHockeyService/EquipSkin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AirHockeyServiceServer).EquipSkin(ctx, req.(*EquipSkinReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AirHockeyService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "AirHockey.AirHockeyService",
 	HandlerType: (*AirHockeyServiceServer)(nil),
@@ -2862,6 +10125,90 @@ var _AirHockeyService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _AirHockeyService_Login_Handler,
 		},
+		{
+			MethodName: "LoginAsGuest",
+			Handler:    _AirHockeyService_LoginAsGuest_Handler,
+		},
+		{
+			MethodName: "ClaimGuestAccount",
+			Handler:    _AirHockeyService_ClaimGuestAccount_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AirHockeyService_Logout_Handler,
+		},
+		{
+			MethodName: "ClaimDailyReward",
+			Handler:    _AirHockeyService_ClaimDailyReward_Handler,
+		},
+		{
+			MethodName: "SendFriendRequest",
+			Handler:    _AirHockeyService_SendFriendRequest_Handler,
+		},
+		{
+			MethodName: "AcceptFriendRequest",
+			Handler:    _AirHockeyService_AcceptFriendRequest_Handler,
+		},
+		{
+			MethodName: "DeclineFriendRequest",
+			Handler:    _AirHockeyService_DeclineFriendRequest_Handler,
+		},
+		{
+			MethodName: "RemoveFriend",
+			Handler:    _AirHockeyService_RemoveFriend_Handler,
+		},
+		{
+			MethodName: "BlockPlayer",
+			Handler:    _AirHockeyService_BlockPlayer_Handler,
+		},
+		{
+			MethodName: "UnblockPlayer",
+			Handler:    _AirHockeyService_UnblockPlayer_Handler,
+		},
+		{
+			MethodName: "GetFriendList",
+			Handler:    _AirHockeyService_GetFriendList_Handler,
+		},
+		{
+			MethodName: "MutePlayer",
+			Handler:    _AirHockeyService_MutePlayer_Handler,
+		},
+		{
+			MethodName: "ReportPlayer",
+			Handler:    _AirHockeyService_ReportPlayer_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _AirHockeyService_ListReports_Handler,
+		},
+		{
+			MethodName: "ModeratePlayer",
+			Handler:    _AirHockeyService_ModeratePlayer_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _AirHockeyService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "ChangeDisplayName",
+			Handler:    _AirHockeyService_ChangeDisplayName_Handler,
+		},
+		{
+			MethodName: "ChangeEmail",
+			Handler:    _AirHockeyService_ChangeEmail_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _AirHockeyService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _AirHockeyService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _AirHockeyService_ResetPassword_Handler,
+		},
 		{
 			MethodName: "NewRoom",
 			Handler:    _AirHockeyService_NewRoom_Handler,
@@ -2870,6 +10217,14 @@ var _AirHockeyService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "JoinRoom",
 			Handler:    _AirHockeyService_JoinRoom_Handler,
 		},
+		{
+			MethodName: "InvitePlayer",
+			Handler:    _AirHockeyService_InvitePlayer_Handler,
+		},
+		{
+			MethodName: "RespondInvitation",
+			Handler:    _AirHockeyService_RespondInvitation_Handler,
+		},
 		{
 			MethodName: "NewRecord",
 			Handler:    _AirHockeyService_NewRecord_Handler,
@@ -2882,6 +10237,38 @@ var _AirHockeyService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddSkin",
 			Handler:    _AirHockeyService_AddSkin_Handler,
 		},
+		{
+			MethodName: "GetTransactions",
+			Handler:    _AirHockeyService_GetTransactions_Handler,
+		},
+		{
+			MethodName: "SendGift",
+			Handler:    _AirHockeyService_SendGift_Handler,
+		},
+		{
+			MethodName: "RespondGift",
+			Handler:    _AirHockeyService_RespondGift_Handler,
+		},
+		{
+			MethodName: "ListGifts",
+			Handler:    _AirHockeyService_ListGifts_Handler,
+		},
+		{
+			MethodName: "GetAchievements",
+			Handler:    _AirHockeyService_GetAchievements_Handler,
+		},
+		{
+			MethodName: "GetMissions",
+			Handler:    _AirHockeyService_GetMissions_Handler,
+		},
+		{
+			MethodName: "ClaimMission",
+			Handler:    _AirHockeyService_ClaimMission_Handler,
+		},
+		{
+			MethodName: "GrantCash",
+			Handler:    _AirHockeyService_GrantCash_Handler,
+		},
 		{
 			MethodName: "GetPlayerList",
 			Handler:    _AirHockeyService_GetPlayerList_Handler,
@@ -2902,8 +10289,37 @@ var _AirHockeyService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSkinList",
 			Handler:    _AirHockeyService_GetSkinList_Handler,
 		},
+		{
+			MethodName: "GetInventory",
+			Handler:    _AirHockeyService_GetInventory_Handler,
+		},
+		{
+			MethodName: "GrantItem",
+			Handler:    _AirHockeyService_GrantItem_Handler,
+		},
+		{
+			MethodName: "GetSkinCatalog",
+			Handler:    _AirHockeyService_GetSkinCatalog_Handler,
+		},
+		{
+			MethodName: "PurchaseSkin",
+			Handler:    _AirHockeyService_PurchaseSkin_Handler,
+		},
+		{
+			MethodName: "GetShop",
+			Handler:    _AirHockeyService_GetShop_Handler,
+		},
+		{
+			MethodName: "EquipSkin",
+			Handler:    _AirHockeyService_EquipSkin_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePresence",
+			Handler:       _AirHockeyService_SubscribePresence_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GameStream",
 			Handler:       _AirHockeyService_GameStream_Handler,
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	displayNameMinLength = 3
+	displayNameMaxLength = 16
+	deletedPlayerName    = "deleted-player" // replaces the user name of deleted accounts in records
+)
+
+var displayNamePattern = regexp.MustCompile(`^[\p{L}\p{N}_\- ]+$`)
+
+// caseInsensitive -- collation used so "Bob" and "bob" count as the same display name
+var caseInsensitive = &options.Collation{Locale: "en", Strength: 2}
+
+func (s *server) ChangePassword(ctx context.Context, in *pb.ChangePasswordReq) (*pb.Empty, error) {
+	user, err := LoginHandler(in.GetAccountInfo().GetUserName(), in.GetAccountInfo().GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	if err := changePassword(user, in.NewPassword); err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, user.PlayerID), "password changed")
+	return &pb.Empty{}, nil
+}
+
+func (s *server) ChangeDisplayName(ctx context.Context, in *pb.NewPlayerName) (*pb.Empty, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	name, err := validateDisplayName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := changeDisplayName(in.Uuid, name); err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "display name changed", "name", name)
+	return &pb.Empty{}, nil
+}
+
+func (s *server) ChangeEmail(ctx context.Context, in *pb.ChangeEmailReq) (*pb.Empty, error) {
+	user, err := LoginHandler(in.GetAccountInfo().GetUserName(), in.GetAccountInfo().GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	email, err := normalizeEmail(in.Email)
+	if err != nil {
+		return nil, err
+	}
+	if err := changeEmail(user, email); err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, user.PlayerID), "email changed")
+	return &pb.Empty{}, nil
+}
+
+func (s *server) DeleteAccount(ctx context.Context, account *pb.Account) (*pb.Empty, error) {
+	user, err := LoginHandler(account.UserName, account.Password)
+	if err != nil {
+		return nil, err
+	}
+	if err := deleteAccount(user); err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, user.PlayerID), "account deleted")
+	return &pb.Empty{}, nil
+}
+
+// validateDisplayName -- trims the name and checks its length and characters
+func validateDisplayName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	length := utf8.RuneCountInString(name)
+	if length < displayNameMinLength || length > displayNameMaxLength {
+		return "", errors.New("display name must be between 3 and 16 characters")
+	}
+	if !displayNamePattern.MatchString(name) {
+		return "", errors.New("display name may only contain letters, digits, spaces, '_' and '-'")
+	}
+	return name, nil
+}
+
+// displayNameTaken -- true when another player already uses the name, ignoring case
+func displayNameTaken(collection *mongo.Collection, name string, playerID string) (bool, error) {
+	filter := bson.M{"name": name, "playerID": bson.M{"$ne": playerID}}
+	count, err := collection.CountDocuments(context.TODO(), filter, options.Count().SetCollation(caseInsensitive))
+	if err != nil {
+		return false, errors.New("[DB] cannot check display name")
+	}
+	return count > 0, nil
+}
+
+func changePassword(user *model.User, newPassword string) error {
+	if err := passwordPolicy.Validate(newPassword); err != nil {
+		return err
+	}
+	hash, err := hashPassword(newPassword)
+	if err != nil {
+		return errors.New("hashing err")
+	}
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return errors.New("[DB] user table err")
+	}
+	_, err = collection.UpdateOne(context.TODO(), bson.M{"playerID": user.PlayerID}, bson.M{"$set": bson.M{"password": hash}})
+	if err != nil {
+		return errors.New("[DB] cannot update password")
+	}
+	return nil
+}
+
+// changeEmail -- an address used by another account is left out silently, see notifyEmailInUse
+func changeEmail(user *model.User, email string) error {
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return errors.New("[DB] user table err")
+	}
+	if email != "" {
+		taken, err := emailTaken(collection, email, user.PlayerID)
+		if err != nil {
+			return err
+		}
+		if taken {
+			go notifyEmailInUse(email)
+			return nil
+		}
+	}
+	_, err = collection.UpdateOne(context.TODO(), bson.M{"playerID": user.PlayerID}, bson.M{"$set": bson.M{"email": email}})
+	if err != nil {
+		return errors.New("[DB] cannot update email")
+	}
+	return nil
+}
+
+func changeDisplayName(playerID string, name string) error {
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return errors.New("[DB] user table err")
+	}
+	taken, err := displayNameTaken(collection, name, playerID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return errors.New("display name already taken")
+	}
+	result, err := collection.UpdateOne(context.TODO(), bson.M{"playerID": playerID}, bson.M{"$set": bson.M{"name": name}})
+	if err != nil {
+		return errors.New("[DB] cannot update display name")
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("no record of user in DB")
+	}
+
+	// keep the cached ranking in line with the new name
+	lock.Lock()
+	for i := range rankList {
+		if rankList[i].PlayerID == playerID {
+			rankList[i].PlayerName = name
+		}
+	}
+	lock.Unlock()
+	return nil
+}
+
+// renameInRecords -- replaces a user name in the team lists of every record
+func renameInRecords(oldUserName string, newUserName string) error {
+	recordCollection, err := db.GetRecordCollection()
+	if err != nil {
+		return errors.New("[DB] connection err")
+	}
+	for _, team := range []string{"team1", "team2"} {
+		filter := bson.M{team: oldUserName}
+		update := bson.M{"$set": bson.M{team + ".$[member]": newUserName}}
+		opt := options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{bson.M{"member": oldUserName}}})
+		if _, err := recordCollection.UpdateMany(context.TODO(), filter, update, opt); err != nil {
+			return errors.New("[DB] cannot update records")
+		}
+	}
+	return nil
+}
+
+// deleteAccount -- removes the user and their items, records keep the match but lose the user name
+func deleteAccount(user *model.User) error {
+	if ClientExists(user.PlayerID) {
+		if err := RemoveInterruptPlayer(user.PlayerID); err != nil {
+			logger.Warn("remove deleted player from server failed", "player", user.PlayerID, "error", err)
+		}
+	}
+
+	if err := renameInRecords(user.UserName, deletedPlayerName); err != nil {
+		return err
+	}
+	if err := removeFromSocial(user.UserName); err != nil {
+		return err
+	}
+
+	skinCollection, err := db.GetSkinCollection()
+	if err != nil {
+		return errors.New("[DB] skin table err")
+	}
+	if _, err := skinCollection.DeleteMany(context.TODO(), bson.M{"playerID": user.PlayerID}); err != nil {
+		return errors.New("[DB] cannot delete skins")
+	}
+	inventoryCollection, err := db.GetInventoryCollection()
+	if err != nil {
+		return errors.New("[DB] inventory table err")
+	}
+	if _, err := inventoryCollection.DeleteMany(context.TODO(), bson.M{"playerID": user.PlayerID}); err != nil {
+		return errors.New("[DB] cannot delete inventory")
+	}
+	loadoutCollection, err := db.GetLoadoutCollection()
+	if err != nil {
+		return errors.New("[DB] loadout table err")
+	}
+	if _, err := loadoutCollection.DeleteMany(context.TODO(), bson.M{"playerID": user.PlayerID}); err != nil {
+		return errors.New("[DB] cannot delete loadout")
+	}
+	statsCollection, err := db.GetPlayerStatsCollection()
+	if err != nil {
+		return errors.New("[DB] player stats table err")
+	}
+	if _, err := statsCollection.DeleteMany(context.TODO(), bson.M{"playerID": user.PlayerID}); err != nil {
+		return errors.New("[DB] cannot delete player stats")
+	}
+	achievementCollection, err := db.GetAchievementCollection()
+	if err != nil {
+		return errors.New("[DB] achievement table err")
+	}
+	if _, err := achievementCollection.DeleteMany(context.TODO(), bson.M{"playerID": user.PlayerID}); err != nil {
+		return errors.New("[DB] cannot delete achievements")
+	}
+	missionCollection, err := db.GetMissionCollection()
+	if err != nil {
+		return errors.New("[DB] mission table err")
+	}
+	if _, err := missionCollection.DeleteMany(context.TODO(), bson.M{"playerID": user.PlayerID}); err != nil {
+		return errors.New("[DB] cannot delete missions")
+	}
+
+	userCollection, err := db.GetUserCollection()
+	if err != nil {
+		return errors.New("[DB] user table err")
+	}
+	if _, err := userCollection.DeleteOne(context.TODO(), bson.M{"playerID": user.PlayerID}); err != nil {
+		return errors.New("[DB] cannot delete user")
+	}
+
+	lock.Lock()
+	for i := range rankList {
+		if rankList[i].PlayerID == user.PlayerID {
+			rankList[i] = model.PlayerRank{}
+		}
+	}
+	lock.Unlock()
+	return nil
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const cashAchievement = "achievement"
+
+// achievementDef -- one achievement, met is checked with the player's totals after each finalized match
+type achievementDef struct {
+	id             string
+	name           string
+	description    string
+	rewardCash     int
+	rewardSkinType int32 // 0 for no skin reward
+	rewardSkinID   int32
+	met            func(match matchResult, stats *model.PlayerStats) bool
+}
+
+// achievements -- every achievement in display order, IDs are stored and must not change
+var achievements = []achievementDef{
+	{
+		id: "first_win", name: "First Win", description: "Win a match",
+		rewardCash: 100,
+		met: func(_ matchResult, stats *model.PlayerStats) bool {
+			return stats.Wins >= 1
+		},
+	},
+	{
+		id: "clean_sheet", name: "Clean Sheet", description: "Win a match without conceding a goal",
+		rewardCash: 200,
+		met: func(match matchResult, _ *model.PlayerStats) bool {
+			return match.won() && match.goalsAgainst == 0
+		},
+	},
+	{
+		id: "shutout_10", name: "Shutout", description: "Win a match 10-0 or better",
+		rewardCash: 300, rewardSkinType: itemPuck, rewardSkinID: 2,
+		met: func(match matchResult, _ *model.PlayerStats) bool {
+			return match.won() && match.goalsFor >= 10 && match.goalsAgainst == 0
+		},
+	},
+	{
+		id: "win_streak_5", name: "On a Roll", description: "Win 5 matches in a row",
+		rewardCash: 500,
+		met: func(_ matchResult, stats *model.PlayerStats) bool {
+			return stats.WinStreak >= 5
+		},
+	},
+	{
+		id: "matches_100", name: "Veteran", description: "Play 100 matches",
+		rewardCash: 1000, rewardSkinType: itemTable, rewardSkinID: 2,
+		met: func(_ matchResult, stats *model.PlayerStats) bool {
+			return stats.MatchesPlayed >= 100
+		},
+	},
+}
+
+func (s *server) GetAchievements(_ context.Context, in *pb.AchievementsReq) (*pb.AchievementList, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	collection, err := db.GetAchievementCollection()
+	if err != nil {
+		return nil, errors.New("[DB] achievement table err")
+	}
+	cursor, err := collection.Find(context.TODO(), bson.M{"playerID": in.Uuid})
+	if err != nil {
+		return nil, errors.New("[DB] err while load achievements")
+	}
+	var unlocked []model.Achievement
+	if err := cursor.All(context.TODO(), &unlocked); err != nil {
+		return nil, errors.New("[DB] err while load achievements")
+	}
+	unlockedAt := make(map[string]time.Time)
+	for _, achievement := range unlocked {
+		unlockedAt[achievement.AchievementID] = achievement.UnlockedAt
+	}
+
+	result := &pb.AchievementList{}
+	for _, def := range achievements {
+		out := achievementMessage(&def)
+		if at, ok := unlockedAt[def.id]; ok {
+			out.Unlocked = true
+			out.UnlockedAt = at.Unix()
+		}
+		result.Achievements = append(result.Achievements, out)
+	}
+	return result, nil
+}
+
+func achievementMessage(def *achievementDef) *pb.Achievement {
+	return &pb.Achievement{
+		Id:             def.id,
+		Name:           def.name,
+		Description:    def.description,
+		RewardCash:     int32(def.rewardCash),
+		RewardSkinType: def.rewardSkinType,
+		RewardSkinID:   def.rewardSkinID,
+	}
+}
+
+// evaluateAchievements -- unlocks what the match earned, rewards it and tells the player if online
+func evaluateAchievements(match matchResult, stats *model.PlayerStats) {
+	for i := range achievements {
+		def := &achievements[i]
+		if !def.met(match, stats) {
+			continue
+		}
+		unlockedAt, unlocked, err := unlockAchievement(match.playerID, def.id)
+		if err != nil {
+			logger.Error("achievement not unlocked", "player", match.playerID, "achievement", def.id, "error", err)
+			continue
+		}
+		if !unlocked {
+			continue
+		}
+		grantAchievementReward(match.playerID, def)
+
+		out := achievementMessage(def)
+		out.Unlocked = true
+		out.UnlockedAt = unlockedAt.Unix()
+		lock.Lock()
+		deliver(players[match.playerID], &pb.GameMessage{Action: &pb.GameMessage_AchievementUnlocked{AchievementUnlocked: out}})
+		lock.Unlock()
+		logger.Info("achievement unlocked", "player", match.playerID, "achievement", def.id)
+	}
+}
+
+// unlockAchievement -- stores the unlock once, false when the player already had it
+func unlockAchievement(playerID string, achievementID string) (time.Time, bool, error) {
+	collection, err := db.GetAchievementCollection()
+	if err != nil {
+		return time.Time{}, false, errors.New("[DB] achievement table err")
+	}
+	now := time.Now()
+	filter := bson.M{"playerID": playerID, "achievementID": achievementID}
+	update := bson.M{"$setOnInsert": bson.M{"unlockedAt": now}}
+	result, err := collection.UpdateOne(context.TODO(), filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return time.Time{}, false, errors.New("[DB] cannot store achievement")
+	}
+	return now, result.UpsertedCount > 0, nil
+}
+
+func grantAchievementReward(playerID string, def *achievementDef) {
+	if def.rewardCash > 0 {
+		if _, err := applyCashChange(playerID, def.rewardCash, cashAchievement, def.id); err != nil {
+			logger.Error("achievement cash not granted", "player", playerID, "achievement", def.id, "error", err)
+		}
+	}
+	if def.rewardSkinType != 0 {
+		if _, err := grantItem(playerID, def.rewardSkinType, def.rewardSkinID, 1); err != nil {
+			logger.Error("achievement skin not granted", "player", playerID, "achievement", def.id, "error", err)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"air-hockey-backend/pb"
+	"bufio"
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const chatMaxLength = 200 // longer messages are cut
+
+var chatLimit = limit{rate: 1, burst: 5}
+
+// profanityPattern -- whole words from the PROFANITY_FILE list (default config/profanity.txt), nil when the list is empty
+var profanityPattern = loadProfanity(profanityFile())
+
+func profanityFile() string {
+	if path := os.Getenv("PROFANITY_FILE"); path != "" {
+		return path
+	}
+	return "config/profanity.txt"
+}
+
+// loadProfanity -- one word per line, blank lines and lines starting with # are ignored
+func loadProfanity(path string) *regexp.Regexp {
+	file, err := os.Open(path)
+	if err != nil {
+		logger.Warn("profanity list not loaded, chat is not filtered", "path", path, "error", err)
+		return nil
+	}
+	defer file.Close()
+	var words []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+		words = append(words, regexp.QuoteMeta(word))
+	}
+	if len(words) == 0 {
+		return nil
+	}
+	return regexp.MustCompile(`(?i)\b(` + strings.Join(words, "|") + `)\b`)
+}
+
+// filterChat -- trims, cuts to the length limit and masks listed words
+func filterChat(text string) string {
+	text = strings.TrimSpace(text)
+	if utf8.RuneCountInString(text) > chatMaxLength {
+		text = string([]rune(text)[:chatMaxLength])
+	}
+	if profanityPattern != nil {
+		text = profanityPattern.ReplaceAllStringFunc(text, func(word string) string {
+			return strings.Repeat("*", utf8.RuneCountInString(word))
+		})
+	}
+	return text
+}
+
+func (s *server) MutePlayer(ctx context.Context, in *pb.MuteRequest) (*pb.Empty, error) {
+	userName, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	update := bson.M{"$pull": bson.M{"muted": in.UserName}}
+	if in.Mute {
+		update = bson.M{"$addToSet": bson.M{"muted": in.UserName}}
+	}
+	if err := updateSocial(userName, update); err != nil {
+		return nil, err
+	}
+	lock.Lock()
+	if player, ok := players[in.Uuid]; ok {
+		player.muted[in.UserName] = in.Mute
+	}
+	lock.Unlock()
+	return &pb.Empty{}, nil
+}
+
+// loadMuted -- fills the session's mute set from the stored list, called after login
+func loadMuted(player *Player) {
+	social, err := getSocial(player.name)
+	if err != nil {
+		logger.Warn("load muted players failed", "player", player.uuid, "error", err)
+		return
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	for _, userName := range social.Muted {
+		player.muted[userName] = true
+	}
+}
+
+// BroadcastChat -- relays a chat message to the room or the lobby, skipping players who muted the sender
+// and dropping messages of players muted by a moderator, msg.Sender is the stream owner
+func BroadcastChat(msg *pb.GameMessage) {
+	if !limiters.allow("chat:"+msg.Sender, chatLimit) {
+		rateLimited.WithLabelValues("chat").Inc()
+		return
+	}
+	chat := msg.GetChatMessage()
+	text := filterChat(chat.Text)
+	if text == "" {
+		return
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	sender, ok := players[msg.Sender]
+	if !ok || time.Now().Before(sender.chatMutedUntil) {
+		return
+	}
+	// no Sender, the player ID would reach every listener
+	out := &pb.GameMessage{
+		Action: &pb.GameMessage_ChatMessage{ChatMessage: &pb.ChatMessage{
+			Channel: chat.Channel, RoomID: chat.RoomID, Text: text, FromUserName: sender.name,
+		}},
+	}
+
+	var recipients []string
+	switch chat.Channel {
+	case pb.ChatChannel_ROOM:
+		room, ok := rooms[chat.RoomID]
+		if !ok || !contains(room.roomPlayers, msg.Sender) {
+			return
+		}
+		recipients = room.roomPlayers
+	case pb.ChatChannel_LOBBY:
+		for id := range players {
+			recipients = append(recipients, id)
+		}
+	}
+	for _, id := range recipients {
+		if player, ok := players[id]; ok && !player.muted[sender.name] {
+			deliver(player, out)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	cashDailyReward = "daily_reward"
+
+	dailyRewardBase      = 100 // reward of the first day
+	dailyRewardStep      = 50  // added for each further day of the streak
+	dailyRewardMaxStreak = 7   // the reward stops growing after this many days
+	dailyDayLayout       = "2006-01-02"
+)
+
+// rewardLocation -- days start at midnight here, REWARD_TIMEZONE (e.g. Asia/Ho_Chi_Minh) overrides UTC
+var rewardLocation = time.UTC
+
+func init() {
+	if name := os.Getenv("REWARD_TIMEZONE"); name != "" {
+		location, err := time.LoadLocation(name)
+		if err != nil {
+			logger.Warn("invalid REWARD_TIMEZONE, days start at UTC midnight", "value", name, "error", err)
+			return
+		}
+		rewardLocation = location
+	}
+}
+
+func (s *server) ClaimDailyReward(ctx context.Context, in *pb.DailyRewardReq) (*pb.DailyReward, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	reward, err := claimDailyReward(in.Uuid, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if reward.Claimed {
+		logger.InfoContext(withPlayer(ctx, in.Uuid), "daily reward claimed", "reward", reward.Reward, "streak", reward.Streak)
+	}
+	return reward, nil
+}
+
+// dailyReward -- the cash of a given streak day
+func dailyReward(streak int) int {
+	if streak > dailyRewardMaxStreak {
+		streak = dailyRewardMaxStreak
+	}
+	return dailyRewardBase + (streak-1)*dailyRewardStep
+}
+
+// claimDailyReward -- grants today's reward once, the day is switched with a conditional update so two
+// logins at the same time cannot both claim it
+func claimDailyReward(playerID string, now time.Time) (*pb.DailyReward, error) {
+	local := now.In(rewardLocation)
+	today := local.Format(dailyDayLayout)
+	yesterday := local.AddDate(0, 0, -1).Format(dailyDayLayout)
+	year, month, day := local.Date()
+	nextClaimAt := time.Date(year, month, day+1, 0, 0, 0, 0, rewardLocation).Unix()
+
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return nil, errors.New("[DB] user table err")
+	}
+	var user model.User
+	if err := collection.FindOne(context.TODO(), bson.M{"playerID": playerID}).Decode(&user); err != nil {
+		return nil, errors.New("no record of user in DB")
+	}
+	if user.LastDailyClaim == today {
+		return &pb.DailyReward{Streak: int32(user.DailyStreak), NextClaimAt: nextClaimAt, Cash: int32(user.Cash)}, nil
+	}
+
+	streak := 1
+	if user.LastDailyClaim == yesterday {
+		streak = user.DailyStreak + 1
+	}
+	filter := bson.M{"playerID": playerID, "lastDailyClaim": user.LastDailyClaim}
+	if user.LastDailyClaim == "" {
+		// accounts from before daily rewards have no lastDailyClaim field, "" alone would not match them
+		filter["lastDailyClaim"] = bson.M{"$in": bson.A{"", nil}}
+	}
+	update := bson.M{"$set": bson.M{"lastDailyClaim": today, "dailyStreak": streak}}
+	result, err := collection.UpdateOne(context.TODO(), filter, update)
+	if err != nil {
+		return nil, errors.New("[DB] cannot update daily reward")
+	}
+	if result.ModifiedCount == 0 {
+		// claimed by another request in between
+		return &pb.DailyReward{Streak: int32(streak), NextClaimAt: nextClaimAt, Cash: int32(user.Cash)}, nil
+	}
+
+	reward := dailyReward(streak)
+	cash, err := applyCashChange(playerID, reward, cashDailyReward, "day "+today+" streak "+strconv.Itoa(streak))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DailyReward{Claimed: true, Reward: int32(reward), Streak: int32(streak), NextClaimAt: nextClaimAt, Cash: int32(cash)}, nil
+}
@@ -0,0 +1,21 @@
+package main
+
+import "testing"
+
+func TestDailyReward(t *testing.T) {
+	tests := []struct {
+		streak int
+		want   int
+	}{
+		{1, 100},
+		{2, 150},
+		{7, 400},
+		{8, 400},
+		{30, 400},
+	}
+	for _, tt := range tests {
+		if got := dailyReward(tt.streak); got != tt.want {
+			t.Errorf("dailyReward(%d) = %d, want %d", tt.streak, got, tt.want)
+		}
+	}
+}
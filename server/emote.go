@@ -0,0 +1,81 @@
+package main
+
+import (
+	"air-hockey-backend/pb"
+	"time"
+)
+
+const emoteCooldown = 3 * time.Second
+
+type emoteDef struct {
+	name string
+	free bool // usable without unlocking
+}
+
+// emotes -- every emote a client may send, the IDs are shared with the client
+var emotes = map[int32]emoteDef{
+	1: {name: "Good game", free: true},
+	2: {name: "Nice shot", free: true},
+	3: {name: "Oops", free: true},
+	4: {name: "Thanks", free: true},
+	5: {name: "Wow", free: false},
+	6: {name: "Too easy", free: false},
+	7: {name: "On fire", free: false},
+	8: {name: "Crown", free: false},
+}
+
+// loadEmotes -- fills the session's unlocked emotes from the inventory, called after login
+func loadEmotes(player *Player) {
+	items, err := inventoryItems(player.uuid, itemEmote)
+	if err != nil {
+		logger.Warn("load emotes failed", "player", player.uuid, "error", err)
+		return
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	for _, item := range items {
+		player.emotes[item.ItemID] = true
+	}
+}
+
+// unlockEmote -- keeps an online player's emotes in line after an emote is granted
+func unlockEmote(playerID string, emoteID int32) {
+	lock.Lock()
+	defer lock.Unlock()
+	if player, ok := players[playerID]; ok {
+		player.emotes[emoteID] = true
+	}
+}
+
+// BroadcastEmote -- relays an emote to the sender's room during a match when it is known, owned and off cooldown
+func BroadcastEmote(msg *pb.GameMessage) {
+	emote := msg.GetEmote()
+	def, ok := emotes[emote.EmoteID]
+	if !ok {
+		return
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	sender, ok := players[msg.Sender]
+	if !ok || (!def.free && !sender.emotes[emote.EmoteID]) {
+		return
+	}
+	room, ok := rooms[emote.RoomID]
+	if !ok || room.state != roomPlaying || !contains(room.roomPlayers, msg.Sender) {
+		return
+	}
+	now := time.Now()
+	if now.Sub(sender.lastEmote) < emoteCooldown {
+		return
+	}
+	sender.lastEmote = now
+
+	out := &pb.GameMessage{
+		Action: &pb.GameMessage_Emote{Emote: &pb.Emote{EmoteID: emote.EmoteID, RoomID: emote.RoomID, FromUserName: sender.name}},
+		Sender: msg.Sender,
+	}
+	for _, id := range room.roomPlayers {
+		deliver(players[id], out)
+	}
+}
@@ -0,0 +1,370 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (s *server) SendFriendRequest(ctx context.Context, in *pb.FriendRequest) (*pb.Empty, error) {
+	userName, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := sendFriendRequest(userName, in.UserName); err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "friend request sent", "to", in.UserName)
+	return &pb.Empty{}, nil
+}
+
+func (s *server) AcceptFriendRequest(ctx context.Context, in *pb.FriendRequest) (*pb.Empty, error) {
+	userName, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := acceptFriendRequest(userName, in.UserName); err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "friend request accepted", "from", in.UserName)
+	return &pb.Empty{}, nil
+}
+
+func (s *server) DeclineFriendRequest(ctx context.Context, in *pb.FriendRequest) (*pb.Empty, error) {
+	userName, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := dropPending(userName, in.UserName); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *server) RemoveFriend(ctx context.Context, in *pb.FriendRequest) (*pb.Empty, error) {
+	userName, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := unfriend(userName, in.UserName); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *server) BlockPlayer(ctx context.Context, in *pb.FriendRequest) (*pb.Empty, error) {
+	userName, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if userName == in.UserName {
+		return nil, errors.New("cannot block yourself")
+	}
+	if err := unfriend(userName, in.UserName); err != nil {
+		return nil, err
+	}
+	if err := dropPending(userName, in.UserName); err != nil {
+		return nil, err
+	}
+	if err := dropPending(in.UserName, userName); err != nil {
+		return nil, err
+	}
+	if err := updateSocial(userName, bson.M{"$addToSet": bson.M{"blocked": in.UserName}}); err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "player blocked", "blocked", in.UserName)
+	return &pb.Empty{}, nil
+}
+
+func (s *server) UnblockPlayer(ctx context.Context, in *pb.FriendRequest) (*pb.Empty, error) {
+	userName, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := updateSocial(userName, bson.M{"$pull": bson.M{"blocked": in.UserName}}); err != nil {
+		return nil, err
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *server) GetFriendList(ctx context.Context, in *pb.SessionReq) (*pb.FriendList, error) {
+	userName, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	return friendList(userName)
+}
+
+// sessionUserName -- the user name of the player owning the session
+func sessionUserName(playerID string, token string) (string, error) {
+	if !validSession(playerID, token) {
+		return "", errors.New("no active session for this player")
+	}
+	return playerUserName(playerID), nil
+}
+
+// playerUserName -- user name of an online player, empty when offline
+func playerUserName(playerID string) string {
+	lock.RLock()
+	defer lock.RUnlock()
+	if player, ok := players[playerID]; ok {
+		return player.name
+	}
+	return ""
+}
+
+// onlineUserNames -- user names of every registered player
+func onlineUserNames() map[string]bool {
+	lock.RLock()
+	defer lock.RUnlock()
+	online := make(map[string]bool, len(players))
+	for _, player := range players {
+		online[player.name] = true
+	}
+	return online
+}
+
+func getSocial(userName string) (*model.Social, error) {
+	collection, err := db.GetSocialCollection()
+	if err != nil {
+		return nil, errors.New("[DB] social table err")
+	}
+	social := &model.Social{UserName: userName}
+	err = collection.FindOne(context.TODO(), bson.M{"userName": userName}).Decode(social)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, errors.New("[DB] err while load friends")
+	}
+	return social, nil
+}
+
+// updateSocial -- applies the update to the user's document, creating it if needed
+func updateSocial(userName string, update bson.M) error {
+	collection, err := db.GetSocialCollection()
+	if err != nil {
+		return errors.New("[DB] social table err")
+	}
+	_, err = collection.UpdateOne(context.TODO(), bson.M{"userName": userName}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return errors.New("[DB] cannot update friends")
+	}
+	return nil
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// isBlockedBetween -- true when either user blocks the other
+func isBlockedBetween(userName string, otherUserName string) (bool, error) {
+	collection, err := db.GetSocialCollection()
+	if err != nil {
+		return false, errors.New("[DB] social table err")
+	}
+	filter := bson.M{"$or": []bson.M{
+		{"userName": userName, "blocked": otherUserName},
+		{"userName": otherUserName, "blocked": userName},
+	}}
+	count, err := collection.CountDocuments(context.TODO(), filter)
+	if err != nil {
+		return false, errors.New("[DB] cannot check block list")
+	}
+	return count > 0, nil
+}
+
+// blockedInRoom -- true when the player blocks, or is blocked by, someone already in the room
+func blockedInRoom(playerID string, roomID string) (bool, error) {
+	lock.RLock()
+	userName := ""
+	if player, ok := players[playerID]; ok {
+		userName = player.name
+	}
+	var members []string
+	if room, ok := rooms[roomID]; ok {
+		for _, memberID := range room.roomPlayers {
+			if member, ok := players[memberID]; ok {
+				members = append(members, member.name)
+			}
+		}
+	}
+	lock.RUnlock()
+
+	for _, member := range members {
+		blocked, err := isBlockedBetween(userName, member)
+		if err != nil || blocked {
+			return blocked, err
+		}
+	}
+	return false, nil
+}
+
+func userExists(userName string) (bool, error) {
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return false, errors.New("[DB] user table err")
+	}
+	count, err := collection.CountDocuments(context.TODO(), bson.M{"userName": userName})
+	if err != nil {
+		return false, errors.New("[DB] cannot find user")
+	}
+	return count > 0, nil
+}
+
+func sendFriendRequest(from string, to string) error {
+	if from == to {
+		return errors.New("cannot add yourself as a friend")
+	}
+	exists, err := userExists(to)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.New("no player with this user name")
+	}
+	blocked, err := isBlockedBetween(from, to)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errors.New("cannot send a friend request to this player")
+	}
+	social, err := getSocial(from)
+	if err != nil {
+		return err
+	}
+	if contains(social.Friends, to) {
+		return errors.New("already friends")
+	}
+	// the other player asked first, treat this as an answer
+	if contains(social.Incoming, to) {
+		return acceptFriendRequest(from, to)
+	}
+	if err := updateSocial(from, bson.M{"$addToSet": bson.M{"outgoing": to}}); err != nil {
+		return err
+	}
+	return updateSocial(to, bson.M{"$addToSet": bson.M{"incoming": from}})
+}
+
+// acceptFriendRequest -- userName accepts the request that requester sent
+func acceptFriendRequest(userName string, requester string) error {
+	collection, err := db.GetSocialCollection()
+	if err != nil {
+		return errors.New("[DB] social table err")
+	}
+	result, err := collection.UpdateOne(context.TODO(),
+		bson.M{"userName": userName, "incoming": requester},
+		bson.M{"$pull": bson.M{"incoming": requester}, "$addToSet": bson.M{"friends": requester}})
+	if err != nil {
+		return errors.New("[DB] cannot update friends")
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("no friend request from this player")
+	}
+	if err := updateSocial(requester, bson.M{"$pull": bson.M{"outgoing": userName}, "$addToSet": bson.M{"friends": userName}}); err != nil {
+		return err
+	}
+	presenceFriendsChanged(userName, requester, true)
+	return nil
+}
+
+// dropPending -- removes the request requester sent to userName, used to decline
+func dropPending(userName string, requester string) error {
+	if err := updateSocial(userName, bson.M{"$pull": bson.M{"incoming": requester}}); err != nil {
+		return err
+	}
+	return updateSocial(requester, bson.M{"$pull": bson.M{"outgoing": userName}})
+}
+
+func unfriend(userName string, friend string) error {
+	if err := updateSocial(userName, bson.M{"$pull": bson.M{"friends": friend}}); err != nil {
+		return err
+	}
+	if err := updateSocial(friend, bson.M{"$pull": bson.M{"friends": userName}}); err != nil {
+		return err
+	}
+	presenceFriendsChanged(userName, friend, false)
+	return nil
+}
+
+// friendList -- friends with display name and online status from the players registry
+func friendList(userName string) (*pb.FriendList, error) {
+	social, err := getSocial(userName)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string)
+	if len(social.Friends) > 0 {
+		collection, err := db.GetUserCollection()
+		if err != nil {
+			return nil, errors.New("[DB] user table err")
+		}
+		cursor, err := collection.Find(context.TODO(), bson.M{"userName": bson.M{"$in": social.Friends}})
+		if err != nil {
+			return nil, errors.New("[DB] err while load friends")
+		}
+		var users []model.User
+		if err := cursor.All(context.TODO(), &users); err != nil {
+			return nil, errors.New("[DB] err while load friends")
+		}
+		for _, user := range users {
+			names[user.UserName] = user.Name
+		}
+	}
+
+	online := onlineUserNames()
+	result := &pb.FriendList{Incoming: social.Incoming, Outgoing: social.Outgoing, Blocked: social.Blocked}
+	for _, friend := range social.Friends {
+		result.Friends = append(result.Friends, &pb.Friend{UserName: friend, Name: names[friend], Online: online[friend]})
+	}
+	return result, nil
+}
+
+var socialLists = []string{"friends", "incoming", "outgoing", "blocked", "muted"}
+
+// renameInSocial -- moves the user's friends and requests to a new user name, used when a guest is claimed
+func renameInSocial(oldUserName string, newUserName string) error {
+	collection, err := db.GetSocialCollection()
+	if err != nil {
+		return errors.New("[DB] social table err")
+	}
+	if _, err := collection.UpdateOne(context.TODO(), bson.M{"userName": oldUserName}, bson.M{"$set": bson.M{"userName": newUserName}}); err != nil {
+		return errors.New("[DB] cannot update friends")
+	}
+	for _, list := range socialLists {
+		update := bson.M{"$set": bson.M{list + ".$[member]": newUserName}}
+		opt := options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{bson.M{"member": oldUserName}}})
+		if _, err := collection.UpdateMany(context.TODO(), bson.M{list: oldUserName}, update, opt); err != nil {
+			return errors.New("[DB] cannot update friends")
+		}
+	}
+	return nil
+}
+
+// removeFromSocial -- deletes the user's document and every mention of them, used when an account is deleted
+func removeFromSocial(userName string) error {
+	collection, err := db.GetSocialCollection()
+	if err != nil {
+		return errors.New("[DB] social table err")
+	}
+	if _, err := collection.DeleteOne(context.TODO(), bson.M{"userName": userName}); err != nil {
+		return errors.New("[DB] cannot delete friends")
+	}
+	pull := bson.M{}
+	for _, list := range socialLists {
+		pull[list] = userName
+	}
+	if _, err := collection.UpdateMany(context.TODO(), bson.M{}, bson.M{"$pull": pull}); err != nil {
+		return errors.New("[DB] cannot delete friends")
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	cashGiftSent     = "gift_sent"
+	cashGiftReceived = "gift_received"
+
+	giftPending  = "pending"
+	giftAccepted = "accepted"
+	giftDeclined = "declined"
+	giftExpired  = "expired"
+
+	giftLifetime   = 7 * 24 * time.Hour // unanswered gifts go back to the sender after this
+	giftDailyCount = 5                  // gifts a player can send per day
+	giftDailyCash  = 2000               // cash a player can send per day, skins not included
+)
+
+func (s *server) SendGift(ctx context.Context, in *pb.SendGiftReq) (*pb.GiftID, error) {
+	userName, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if userName == in.UserName {
+		return nil, errors.New("cannot send a gift to yourself")
+	}
+	if (in.SkinType != 0) == (in.Cash != 0) {
+		return nil, errors.New("a gift is either a skin or cash")
+	}
+	if in.Cash < 0 {
+		return nil, errors.New("cash must be positive")
+	}
+	guest, err := isGuest(in.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if guest {
+		// guests start with cash, many of them must not feed one account
+		return nil, errors.New("guests cannot send gifts, claim the account first")
+	}
+	social, err := getSocial(userName)
+	if err != nil {
+		return nil, err
+	}
+	if !contains(social.Friends, in.UserName) {
+		return nil, errors.New("gifts can only be sent to friends")
+	}
+	recipientID, err := playerIDByUserName(in.UserName)
+	if err != nil {
+		return nil, err
+	}
+
+	gift := &model.Gift{
+		GiftID:       uuid.New().String(),
+		FromPlayerID: in.Uuid,
+		FromUserName: userName,
+		ToPlayerID:   recipientID,
+		ToUserName:   in.UserName,
+		Cash:         int(in.Cash),
+		Status:       giftPending,
+		CreatedAt:    time.Now(),
+	}
+	stockOffer := &shopOffer{} // limited shop items, a cash gift takes no stock
+	if in.SkinType != 0 {
+		skin, err := catalogSkin(in.SkinType, in.SkinID)
+		if err != nil {
+			return nil, err
+		}
+		if !skinAvailable(skin, gift.CreatedAt) {
+			return nil, errors.New("this skin is not available")
+		}
+		owned, err := ownsItem(recipientID, in.SkinType, in.SkinID)
+		if err != nil {
+			return nil, err
+		}
+		if owned {
+			return nil, errors.New("your friend already owns this skin")
+		}
+		offer, err := currentOffer(skin, gift.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		gift.SkinType, gift.SkinID, gift.Cash = in.SkinType, in.SkinID, offer.price
+		stockOffer = offer
+	}
+	if err := reserveGiftCaps(in.Uuid, gift); err != nil {
+		return nil, err
+	}
+	if err := reserveStock(stockOffer, gift.SkinType, gift.SkinID); err != nil {
+		releaseGiftCaps(in.Uuid, gift)
+		return nil, err
+	}
+	gift.RotationID = stockOffer.rotationID // released again by every refund
+
+	reference := "gift " + gift.GiftID
+	if gift.Cash > 0 {
+		if _, err := applyCashChange(in.Uuid, -gift.Cash, cashGiftSent, reference); err != nil {
+			releaseStock(gift.RotationID, gift.SkinType, gift.SkinID)
+			releaseGiftCaps(in.Uuid, gift)
+			return nil, err
+		}
+	}
+	collection, err := db.GetGiftCollection()
+	if err == nil {
+		_, err = collection.InsertOne(context.TODO(), gift)
+	}
+	if err != nil {
+		refundGift(gift)
+		releaseGiftCaps(in.Uuid, gift)
+		return nil, errors.New("[DB] cannot store gift")
+	}
+
+	lock.Lock()
+	deliver(players[recipientID], &pb.GameMessage{Action: &pb.GameMessage_Gift{Gift: giftMessage(gift)}})
+	lock.Unlock()
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "gift sent", "gift", gift.GiftID, "to", in.UserName, "skinType", gift.SkinType, "skinID", gift.SkinID, "cash", gift.Cash)
+	return &pb.GiftID{GiftID: gift.GiftID}, nil
+}
+
+func (s *server) RespondGift(ctx context.Context, in *pb.GiftResponse) (*pb.Empty, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	status := giftDeclined
+	if in.Accept {
+		status = giftAccepted
+	}
+	collection, err := db.GetGiftCollection()
+	if err != nil {
+		return nil, errors.New("[DB] gift table err")
+	}
+	var gift model.Gift
+	// expireGifts runs hourly, a gift past its lifetime cannot be answered meanwhile
+	filter := bson.M{"giftID": in.GiftID, "toPlayerID": in.Uuid, "status": giftPending, "createdAt": bson.M{"$gte": time.Now().Add(-giftLifetime)}}
+	update := bson.M{"$set": bson.M{"status": status, "respondedAt": time.Now()}}
+	err = collection.FindOneAndUpdate(context.TODO(), filter, update).Decode(&gift)
+	if err == mongo.ErrNoDocuments {
+		return nil, errors.New("no pending gift with this ID")
+	}
+	if err != nil {
+		return nil, errors.New("[DB] cannot update gift")
+	}
+	ctx = withPlayer(ctx, in.Uuid)
+
+	if !in.Accept {
+		refundGift(&gift)
+		logger.InfoContext(ctx, "gift declined", "gift", gift.GiftID)
+		return &pb.Empty{}, nil
+	}
+
+	reference := "gift " + gift.GiftID
+	if gift.SkinType == 0 {
+		if _, err := applyCashChange(in.Uuid, gift.Cash, cashGiftReceived, reference); err != nil {
+			reopenGift(gift.GiftID)
+			return nil, err
+		}
+	} else {
+		added, err := grantItem(in.Uuid, gift.SkinType, gift.SkinID, 1)
+		if err != nil {
+			reopenGift(gift.GiftID)
+			return nil, err
+		}
+		if !added {
+			// bought in the meantime, the sender gets the price back
+			if _, err := collection.UpdateOne(context.TODO(), bson.M{"giftID": gift.GiftID}, bson.M{"$set": bson.M{"status": giftDeclined}}); err != nil {
+				logger.ErrorContext(ctx, "gift not marked as declined", "gift", gift.GiftID, "error", err)
+			}
+			refundGift(&gift)
+			return nil, errors.New("skin already owned, the gift went back to the sender")
+		}
+	}
+	logger.InfoContext(ctx, "gift accepted", "gift", gift.GiftID, "from", gift.FromUserName)
+	return &pb.Empty{}, nil
+}
+
+func (s *server) ListGifts(_ context.Context, in *pb.GiftsReq) (*pb.GiftList, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	collection, err := db.GetGiftCollection()
+	if err != nil {
+		return nil, errors.New("[DB] gift table err")
+	}
+	filter := bson.M{"status": giftPending, "$or": []bson.M{{"toPlayerID": in.Uuid}, {"fromPlayerID": in.Uuid}}}
+	cursor, err := collection.Find(context.TODO(), filter, options.Find().SetSort(bson.M{"createdAt": -1}))
+	if err != nil {
+		return nil, errors.New("[DB] err while load gifts")
+	}
+	var gifts []model.Gift
+	if err := cursor.All(context.TODO(), &gifts); err != nil {
+		return nil, errors.New("[DB] err while load gifts")
+	}
+
+	result := &pb.GiftList{}
+	for i := range gifts {
+		if gifts[i].ToPlayerID == in.Uuid {
+			result.Received = append(result.Received, giftMessage(&gifts[i]))
+		} else {
+			result.Sent = append(result.Sent, giftMessage(&gifts[i]))
+		}
+	}
+	return result, nil
+}
+
+func giftMessage(gift *model.Gift) *pb.Gift {
+	return &pb.Gift{
+		GiftID:       gift.GiftID,
+		FromUserName: gift.FromUserName,
+		ToUserName:   gift.ToUserName,
+		SkinType:     gift.SkinType,
+		SkinID:       gift.SkinID,
+		Cash:         int32(gift.Cash),
+		CreatedAt:    gift.CreatedAt.Unix(),
+		ExpiresAt:    gift.CreatedAt.Add(giftLifetime).Unix(),
+	}
+}
+
+// reserveGiftCaps -- counts the gift against the sender's day in one conditional update, so concurrent
+// gifts cannot go past the daily number of gifts or cash sent
+func reserveGiftCaps(playerID string, gift *model.Gift) error {
+	collection, err := db.GetGiftCapCollection()
+	if err != nil {
+		return errors.New("[DB] gift cap table err")
+	}
+	key := bson.M{"playerID": playerID, "day": giftDay(gift)}
+	if _, err := collection.UpdateOne(context.TODO(), key, bson.M{"$setOnInsert": bson.M{"count": 0, "cash": 0}}, options.Update().SetUpsert(true)); err != nil {
+		return errors.New("[DB] cannot update gift caps")
+	}
+	cash := giftCash(gift)
+	filter := bson.M{"playerID": playerID, "day": giftDay(gift), "count": bson.M{"$lt": giftDailyCount}, "cash": bson.M{"$lte": giftDailyCash - cash}}
+	result, err := collection.UpdateOne(context.TODO(), filter, bson.M{"$inc": bson.M{"count": 1, "cash": cash}})
+	if err != nil {
+		return errors.New("[DB] cannot update gift caps")
+	}
+	if result.ModifiedCount == 0 {
+		var caps model.GiftCap
+		if err := collection.FindOne(context.TODO(), key).Decode(&caps); err == nil && caps.Count < giftDailyCount {
+			return errors.New("daily cash gift limit reached")
+		}
+		return errors.New("daily gift limit reached")
+	}
+	return nil
+}
+
+// releaseGiftCaps -- gives back what a gift that was never stored reserved
+func releaseGiftCaps(playerID string, gift *model.Gift) {
+	collection, err := db.GetGiftCapCollection()
+	if err == nil {
+		filter := bson.M{"playerID": playerID, "day": giftDay(gift)}
+		_, err = collection.UpdateOne(context.TODO(), filter, bson.M{"$inc": bson.M{"count": -1, "cash": -giftCash(gift)}})
+	}
+	if err != nil {
+		logger.Error("gift caps not released", "gift", gift.GiftID, "player", playerID, "error", err)
+	}
+}
+
+func giftDay(gift *model.Gift) string {
+	return gift.CreatedAt.In(rewardLocation).Format("2006-01-02")
+}
+
+// giftCash -- what a gift counts against the daily cash cap, skins do not
+func giftCash(gift *model.Gift) int {
+	if gift.SkinType != 0 {
+		return 0
+	}
+	return gift.Cash
+}
+
+// refundGift -- gives the sender back what the gift cost and a limited skin back to the shop
+func refundGift(gift *model.Gift) {
+	releaseStock(gift.RotationID, gift.SkinType, gift.SkinID)
+	if gift.Cash <= 0 {
+		return
+	}
+	if _, err := applyCashChange(gift.FromPlayerID, gift.Cash, cashRefund, "gift "+gift.GiftID); err != nil {
+		logger.Error("gift refund failed", "gift", gift.GiftID, "player", gift.FromPlayerID, "amount", gift.Cash, "error", err)
+	}
+}
+
+// reopenGift -- puts an accepted gift back to pending when it could not be delivered
+func reopenGift(giftID string) {
+	collection, err := db.GetGiftCollection()
+	if err == nil {
+		_, err = collection.UpdateOne(context.TODO(), bson.M{"giftID": giftID}, bson.M{"$set": bson.M{"status": giftPending}})
+	}
+	if err != nil {
+		logger.Error("gift not reopened", "gift", giftID, "error", err)
+	}
+}
+
+// expireGifts -- refunds gifts nobody answered in time, runs until the server shuts down
+func expireGifts() {
+	for {
+		time.Sleep(time.Hour)
+		collection, err := db.GetGiftCollection()
+		if err != nil {
+			logger.Warn("gift expiry skipped", "error", err)
+			continue
+		}
+		filter := bson.M{"status": giftPending, "createdAt": bson.M{"$lt": time.Now().Add(-giftLifetime)}}
+		cursor, err := collection.Find(context.TODO(), filter)
+		if err != nil {
+			logger.Warn("gift expiry skipped", "error", err)
+			continue
+		}
+		var gifts []model.Gift
+		if err := cursor.All(context.TODO(), &gifts); err != nil {
+			logger.Warn("gift expiry skipped", "error", err)
+			continue
+		}
+		for i := range gifts {
+			// only the request that moves it out of pending refunds it
+			result, err := collection.UpdateOne(context.TODO(), bson.M{"giftID": gifts[i].GiftID, "status": giftPending}, bson.M{"$set": bson.M{"status": giftExpired}})
+			if err != nil || result.ModifiedCount == 0 {
+				continue
+			}
+			refundGift(&gifts[i])
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	guestUserNamePrefix = "guest-"            // reserved for generated guest user names, full accounts cannot use it
+	guestLifetime       = 30 * 24 * time.Hour // guests not claimed by then are removed with their items
+)
+
+func (s *server) LoginAsGuest(ctx context.Context, _ *pb.Empty) (*pb.LoginPlayerInfo, error) {
+	guest, err := createGuest()
+	if err != nil {
+		return nil, err
+	}
+	player, err := AddPlayer(guest.PlayerID, guest.UserName)
+	if err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, guest.PlayerID), "guest logged in", "name", guest.Name)
+	return &pb.LoginPlayerInfo{Name: guest.Name, Uuid: guest.PlayerID, Cash: int32(guest.Cash), Rank: int32(guest.Rank), Guest: true, SessionToken: player.token}, nil
+}
+
+func (s *server) ClaimGuestAccount(ctx context.Context, in *pb.ClaimGuestReq) (*pb.LoginPlayerInfo, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this guest")
+	}
+	user, err := claimGuest(in)
+	if err != nil {
+		return nil, err
+	}
+	lock.Lock()
+	if player, ok := players[user.PlayerID]; ok {
+		player.name = user.UserName
+	}
+	lock.Unlock()
+	logger.InfoContext(withPlayer(ctx, user.PlayerID), "guest account claimed", "userName", user.UserName)
+	return &pb.LoginPlayerInfo{Name: user.Name, Uuid: user.PlayerID, Cash: int32(user.Cash), Rank: int32(user.Rank), SessionToken: in.SessionToken}, nil
+}
+
+// validateUserName -- user names must be non-empty and cannot look like a guest
+func validateUserName(userName string) error {
+	if strings.TrimSpace(userName) == "" {
+		return errors.New("user name is required")
+	}
+	if strings.HasPrefix(strings.ToLower(userName), guestUserNamePrefix) {
+		return errors.New("user names starting with " + guestUserNamePrefix + " are reserved")
+	}
+	return nil
+}
+
+// isGuest -- true while the player's account is an unclaimed guest
+func isGuest(playerID string) (bool, error) {
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return false, errors.New("[DB] user table err")
+	}
+	count, err := collection.CountDocuments(context.TODO(), bson.M{"playerID": playerID, "guest": true})
+	if err != nil {
+		return false, errors.New("[DB] cannot check guest")
+	}
+	return count > 0, nil
+}
+
+// createGuest -- stores a user without password and a generated, unused display name
+func createGuest() (*model.User, error) {
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return nil, errors.New("[DB] user table err")
+	}
+	playerID := uuid.New().String()
+	guest := &model.User{
+		PlayerID:  playerID,
+		UserName:  guestUserNamePrefix + playerID,
+		Cash:      startingCash,
+		Guest:     true,
+		CreatedAt: time.Now(),
+	}
+	for attempt := 0; attempt < 5 && guest.Name == ""; attempt++ {
+		n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+		if err != nil {
+			return nil, err
+		}
+		name := fmt.Sprintf("Guest-%06d", n.Int64())
+		taken, err := displayNameTaken(collection, name, playerID)
+		if err != nil {
+			return nil, err
+		}
+		if !taken {
+			guest.Name = name
+		}
+	}
+	if guest.Name == "" {
+		return nil, errors.New("cannot generate a guest name, try again")
+	}
+	if _, err := collection.InsertOne(context.TODO(), guest); err != nil {
+		return nil, errors.New("create guest err")
+	}
+	return guest, nil
+}
+
+// claimGuest -- gives the guest a user name, password and name; cash, skins and records stay with the player ID
+func claimGuest(in *pb.ClaimGuestReq) (*model.User, error) {
+	userName := in.GetAccountInfo().GetUserName()
+	if err := validateUserName(userName); err != nil {
+		return nil, err
+	}
+	if err := passwordPolicy.Validate(in.GetAccountInfo().GetPassword()); err != nil {
+		return nil, err
+	}
+	name, err := validateDisplayName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+	email, err := normalizeEmail(in.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return nil, errors.New("[DB] user table err")
+	}
+	var guest model.User
+	err = collection.FindOne(context.TODO(), bson.M{"playerID": in.Uuid, "guest": true}).Decode(&guest)
+	if err != nil {
+		return nil, errors.New("no guest account with this ID")
+	}
+	count, err := collection.CountDocuments(context.TODO(), bson.M{"userName": userName})
+	if err != nil {
+		return nil, errors.New("[DB] cannot check user name")
+	}
+	if count > 0 {
+		return nil, errors.New("user name already registered")
+	}
+	taken, err := displayNameTaken(collection, name, guest.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, errors.New("display name already taken")
+	}
+	emailInUse := ""
+	if email != "" {
+		taken, err := emailTaken(collection, email, guest.PlayerID)
+		if err != nil {
+			return nil, err
+		}
+		if taken {
+			emailInUse, email = email, ""
+		}
+	}
+
+	hash, err := hashPassword(in.GetAccountInfo().GetPassword())
+	if err != nil {
+		return nil, errors.New("hashing err")
+	}
+	update := bson.M{"$set": bson.M{
+		"userName": userName,
+		"password": hash,
+		"name":     name,
+		"email":    email,
+		"guest":    false,
+	}}
+	// the guest flag in the filter stops two concurrent claims of the same guest
+	result, err := collection.UpdateOne(context.TODO(), bson.M{"playerID": guest.PlayerID, "guest": true}, update)
+	if err != nil || result.ModifiedCount == 0 {
+		return nil, errors.New("cannot claim guest account")
+	}
+	if emailInUse != "" {
+		go notifyEmailInUse(emailInUse)
+	}
+	if err := renameInRecords(guest.UserName, userName); err != nil {
+		logger.Warn("move guest records to claimed account failed", "player", guest.PlayerID, "error", err)
+	}
+	if err := renameInSocial(guest.UserName, userName); err != nil {
+		logger.Warn("move guest friends to claimed account failed", "player", guest.PlayerID, "error", err)
+	}
+
+	guest.UserName = userName
+	guest.Password = hash
+	guest.Name = name
+	guest.Email = email
+	guest.Guest = false
+	return &guest, nil
+}
+
+// expireGuests -- removes guests that were never claimed, runs until the server shuts down
+func expireGuests() {
+	for {
+		time.Sleep(time.Hour)
+		collection, err := db.GetUserCollection()
+		if err != nil {
+			logger.Warn("guest expiry skipped", "error", err)
+			continue
+		}
+		// guests from before createdAt was stored start their lifetime now
+		if _, err := collection.UpdateMany(context.TODO(), bson.M{"guest": true, "createdAt": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"createdAt": time.Now()}}); err != nil {
+			logger.Warn("guest expiry skipped", "error", err)
+			continue
+		}
+		filter := bson.M{"guest": true, "createdAt": bson.M{"$lt": time.Now().Add(-guestLifetime)}}
+		cursor, err := collection.Find(context.TODO(), filter)
+		if err != nil {
+			logger.Warn("guest expiry skipped", "error", err)
+			continue
+		}
+		var guests []model.User
+		if err := cursor.All(context.TODO(), &guests); err != nil {
+			logger.Warn("guest expiry skipped", "error", err)
+			continue
+		}
+		for i := range guests {
+			// a guest has no password, once offline nobody can claim it any more
+			if ClientExists(guests[i].PlayerID) {
+				continue
+			}
+			if err := deleteAccount(&guests[i]); err != nil {
+				logger.Warn("expired guest not removed", "player", guests[i].PlayerID, "error", err)
+				continue
+			}
+			logger.Info("expired guest removed", "player", guests[i].PlayerID)
+		}
+	}
+}
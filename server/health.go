@@ -0,0 +1,69 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"context"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	serviceName         = "AirHockey.AirHockeyService"
+	databaseCheckPeriod = 5 * time.Second
+	databaseCheckWait   = 2 * time.Second
+	drainPeriod         = 10 * time.Second // time given to load balancers to notice NOT_SERVING before the server stops
+)
+
+var healthServer = health.NewServer()
+
+// setServing -- updates both the overall status and the AirHockey service status
+func setServing(serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	healthServer.SetServingStatus("", status)
+	healthServer.SetServingStatus(serviceName, status)
+}
+
+// watchDatabase -- reports NOT_SERVING while MongoDB cannot be reached, runs until the server shuts down
+func watchDatabase() {
+	serving := false
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), databaseCheckWait)
+		err := db.Ping(ctx)
+		cancel()
+		if (err == nil) != serving {
+			serving = err == nil
+			if serving {
+				logger.Info("database reachable, serving")
+			} else {
+				logger.Error("database unreachable, not serving", "error", err)
+			}
+		}
+		setServing(serving)
+		time.Sleep(databaseCheckPeriod)
+	}
+}
+
+func isReady() bool {
+	resp, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: serviceName})
+	return err == nil && resp.Status == healthpb.HealthCheckResponse_SERVING
+}
+
+// handleHealthz -- liveness, the process is up and answering
+func handleHealthz(w http.ResponseWriter, _ *http.Request) {
+	w.Write([]byte("ok\n"))
+}
+
+// handleReadyz -- readiness, the database is reachable and the server is not draining
+func handleReadyz(w http.ResponseWriter, _ *http.Request) {
+	if !isReady() {
+		http.Error(w, "not ready", http.StatusServiceUnavailable)
+		return
+	}
+	w.Write([]byte("ready\n"))
+}
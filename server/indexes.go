@@ -0,0 +1,43 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// uniqueIndex -- the key an upsert filters on, the index keeps concurrent upserts from inserting it twice
+type uniqueIndex struct {
+	name       string
+	collection func() (*mongo.Collection, error)
+	keys       bson.D
+}
+
+var uniqueIndexes = []uniqueIndex{
+	{"inventory", db.GetInventoryCollection, bson.D{{Key: "playerID", Value: 1}, {Key: "itemType", Value: 1}, {Key: "itemID", Value: 1}}},
+	{"achievements", db.GetAchievementCollection, bson.D{{Key: "playerID", Value: 1}, {Key: "achievementID", Value: 1}}},
+	{"missions", db.GetMissionCollection, bson.D{{Key: "playerID", Value: 1}, {Key: "missionID", Value: 1}, {Key: "period", Value: 1}}},
+	{"shopStock", db.GetShopStockCollection, bson.D{{Key: "rotationID", Value: 1}, {Key: "skinType", Value: 1}, {Key: "skinID", Value: 1}}},
+	{"loadouts", db.GetLoadoutCollection, bson.D{{Key: "playerID", Value: 1}}},
+	{"playerStats", db.GetPlayerStatsCollection, bson.D{{Key: "playerID", Value: 1}}},
+	{"giftCaps", db.GetGiftCapCollection, bson.D{{Key: "playerID", Value: 1}, {Key: "day", Value: 1}}},
+	{"social", db.GetSocialCollection, bson.D{{Key: "userName", Value: 1}}},
+}
+
+// ensureIndexes -- creates the unique indexes at startup, existing indexes are left as they are
+func ensureIndexes() {
+	for _, index := range uniqueIndexes {
+		collection, err := index.collection()
+		if err != nil {
+			logger.Warn("index not created", "collection", index.name, "error", err)
+			continue
+		}
+		model := mongo.IndexModel{Keys: index.keys, Options: options.Index().SetUnique(true)}
+		if _, err := collection.Indexes().CreateOne(context.TODO(), model); err != nil {
+			logger.Error("index not created", "collection", index.name, "error", err)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// item types, shared with the client and used as SkinType in the skin RPCs
+const (
+	itemPuck       = 1
+	itemStriker    = 2
+	itemTable      = 3
+	itemEmote      = 4
+	itemGoalEffect = 5
+	itemTitle      = 6
+	itemBanner     = 7
+)
+
+type itemTypeDef struct {
+	name       string
+	consumable bool   // owned in quantities and used up, otherwise owned once
+	equipSlot  string // model.Loadout field it is equipped in, empty when it cannot be equipped
+}
+
+// itemTypes -- every item type the inventory accepts, a new cosmetic only needs an entry here
+var itemTypes = map[int32]itemTypeDef{
+	itemPuck:       {name: "puck", equipSlot: "puckSkin"},
+	itemStriker:    {name: "striker", equipSlot: "strikerSkin"},
+	itemTable:      {name: "table", equipSlot: "tableSkin"},
+	itemEmote:      {name: "emote"},
+	itemGoalEffect: {name: "goal effect", consumable: true},
+	itemTitle:      {name: "title"},
+	itemBanner:     {name: "banner"},
+}
+
+func (s *server) GetInventory(_ context.Context, in *pb.InventoryReq) (*pb.Inventory, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	items, err := inventoryItems(in.Uuid, in.ItemType)
+	if err != nil {
+		return nil, err
+	}
+	result := &pb.Inventory{}
+	for i := range items {
+		result.Items = append(result.Items, inventoryItemMessage(&items[i]))
+	}
+	return result, nil
+}
+
+func (s *server) GrantItem(ctx context.Context, in *pb.GrantItemReq) (*pb.InventoryItem, error) {
+	if _, err := requireAdmin(in.Uuid, in.SessionToken); err != nil {
+		return nil, err
+	}
+	if in.ItemType == itemEmote {
+		if _, ok := emotes[in.ItemID]; !ok {
+			return nil, errors.New("unknown emote")
+		}
+	}
+	playerID, err := playerIDByUserName(in.UserName)
+	if err != nil {
+		return nil, err
+	}
+	quantity := int(in.Quantity)
+	if quantity <= 0 {
+		quantity = 1
+	}
+	if _, err := grantItem(playerID, in.ItemType, in.ItemID, quantity); err != nil {
+		return nil, err
+	}
+	item, err := inventoryItem(playerID, in.ItemType, in.ItemID)
+	if err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "item granted", "target", in.UserName, "itemType", in.ItemType, "itemID", in.ItemID, "quantity", quantity)
+	return inventoryItemMessage(item), nil
+}
+
+func inventoryItemMessage(item *model.InventoryItem) *pb.InventoryItem {
+	return &pb.InventoryItem{
+		ItemType:   item.ItemType,
+		ItemID:     item.ItemID,
+		Quantity:   int32(item.Quantity),
+		AcquiredAt: item.AcquiredAt.Unix(),
+	}
+}
+
+// grantItem -- adds the item to the inventory, consumables add to their quantity while other items are
+// owned once, false when nothing changed because the item was already owned
+func grantItem(playerID string, itemType int32, itemID int32, quantity int) (bool, error) {
+	def, ok := itemTypes[itemType]
+	if !ok {
+		return false, errors.New("unknown item type")
+	}
+	collection, err := db.GetInventoryCollection()
+	if err != nil {
+		return false, errors.New("[DB] inventory table err")
+	}
+	filter := bson.M{"playerID": playerID, "itemType": itemType, "itemID": itemID}
+	update := bson.M{"$setOnInsert": bson.M{"quantity": 1, "acquiredAt": time.Now()}}
+	if def.consumable {
+		update = bson.M{"$inc": bson.M{"quantity": quantity}, "$setOnInsert": bson.M{"acquiredAt": time.Now()}}
+	}
+	result, err := collection.UpdateOne(context.TODO(), filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		// a concurrent grant inserted the item first, the retry updates it instead
+		result, err = collection.UpdateOne(context.TODO(), filter, update)
+	}
+	if err != nil {
+		return false, errors.New("[DB] cannot update inventory")
+	}
+	if itemType == itemEmote {
+		unlockEmote(playerID, itemID)
+	}
+	return result.ModifiedCount > 0 || result.UpsertedCount > 0, nil
+}
+
+// ownsItem -- true when the player has at least one of the item
+func ownsItem(playerID string, itemType int32, itemID int32) (bool, error) {
+	if _, ok := itemTypes[itemType]; !ok {
+		return false, errors.New("unknown item type")
+	}
+	collection, err := db.GetInventoryCollection()
+	if err != nil {
+		return false, errors.New("[DB] inventory table err")
+	}
+	filter := bson.M{"playerID": playerID, "itemType": itemType, "itemID": itemID, "quantity": bson.M{"$gt": 0}}
+	count, err := collection.CountDocuments(context.TODO(), filter)
+	if err != nil {
+		return false, errors.New("[DB] err while load inventory")
+	}
+	return count > 0, nil
+}
+
+func inventoryItem(playerID string, itemType int32, itemID int32) (*model.InventoryItem, error) {
+	collection, err := db.GetInventoryCollection()
+	if err != nil {
+		return nil, errors.New("[DB] inventory table err")
+	}
+	var item model.InventoryItem
+	err = collection.FindOne(context.TODO(), bson.M{"playerID": playerID, "itemType": itemType, "itemID": itemID}).Decode(&item)
+	if err == mongo.ErrNoDocuments {
+		return nil, errors.New("item not owned")
+	}
+	if err != nil {
+		return nil, errors.New("[DB] err while load inventory")
+	}
+	return &item, nil
+}
+
+// inventoryItems -- the player's items of one type, or of every type when itemType is 0
+func inventoryItems(playerID string, itemType int32) ([]model.InventoryItem, error) {
+	collection, err := db.GetInventoryCollection()
+	if err != nil {
+		return nil, errors.New("[DB] inventory table err")
+	}
+	filter := bson.M{"playerID": playerID, "quantity": bson.M{"$gt": 0}}
+	if itemType != 0 {
+		filter["itemType"] = itemType
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "itemType", Value: 1}, {Key: "itemID", Value: 1}})
+	cursor, err := collection.Find(context.TODO(), filter, opt)
+	if err != nil {
+		return nil, errors.New("[DB] err while load inventory")
+	}
+	var items []model.InventoryItem
+	if err := cursor.All(context.TODO(), &items); err != nil {
+		return nil, errors.New("[DB] err while load inventory")
+	}
+	return items, nil
+}
+
+// migrateSkins -- copies the old skin lists into the inventory once, safe to run again after a failure
+func migrateSkins() {
+	skinCollection, err := db.GetSkinCollection()
+	if err != nil {
+		logger.Warn("skin migration skipped", "error", err)
+		return
+	}
+	cursor, err := skinCollection.Find(context.TODO(), bson.M{"migrated": bson.M{"$ne": true}})
+	if err != nil {
+		logger.Warn("skin migration skipped", "error", err)
+		return
+	}
+	var skins []model.Skin
+	if err := cursor.All(context.TODO(), &skins); err != nil {
+		logger.Warn("skin migration skipped", "error", err)
+		return
+	}
+
+	migrated := 0
+	for _, skin := range skins {
+		lists := map[int32][]int32{
+			itemPuck:    skin.PuckSkin,
+			itemStriker: skin.StrikerSkin,
+			itemTable:   skin.TableSkin,
+			itemEmote:   skin.EmoteSkin,
+		}
+		failed := false
+		for itemType, ids := range lists {
+			for _, id := range ids {
+				if _, err := grantItem(skin.PlayerID, itemType, id, 1); err != nil {
+					logger.Error("skin not migrated", "player", skin.PlayerID, "itemType", itemType, "itemID", id, "error", err)
+					failed = true
+				}
+			}
+		}
+		if failed {
+			continue
+		}
+		if _, err := skinCollection.UpdateOne(context.TODO(), bson.M{"playerID": skin.PlayerID}, bson.M{"$set": bson.M{"migrated": true}}); err != nil {
+			logger.Error("skin record not marked as migrated", "player", skin.PlayerID, "error", err)
+			continue
+		}
+		migrated++
+	}
+	if migrated > 0 {
+		logger.Info("skins migrated to the inventory", "players", migrated)
+	}
+}
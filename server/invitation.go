@@ -0,0 +1,159 @@
+package main
+
+import (
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const invitationLifetime = 60 * time.Second
+
+type invitation struct {
+	id           string
+	roomID       string
+	from         string // host player ID
+	fromUserName string
+	to           string // invited player ID
+	toUserName   string
+	expiresAt    time.Time
+}
+
+var (
+	invitationMu sync.Mutex
+	invitations  = make(map[string]*invitation)
+)
+
+func (s *server) InvitePlayer(ctx context.Context, in *pb.InviteRequest) (*pb.Invitation, error) {
+	hostUserName, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if hostUserName == in.UserName {
+		return nil, errors.New("cannot invite yourself")
+	}
+	blocked, err := isBlockedBetween(hostUserName, in.UserName)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, errors.New("cannot invite this player")
+	}
+
+	inv := &invitation{
+		id:           uuid.New().String(),
+		roomID:       in.RoomID,
+		from:         in.Uuid,
+		fromUserName: hostUserName,
+		toUserName:   in.UserName,
+		expiresAt:    time.Now().Add(invitationLifetime),
+	}
+	msg := &pb.Invitation{InvitationID: inv.id, RoomID: inv.roomID, FromUserName: hostUserName, ExpiresAt: inv.expiresAt.Unix()}
+
+	lock.Lock()
+	room, ok := rooms[in.RoomID]
+	if !ok || room.host != in.Uuid {
+		lock.Unlock()
+		return nil, errors.New("only the host of the room can invite")
+	}
+	if len(room.roomPlayers) >= int(room.maxPlayer) {
+		lock.Unlock()
+		return nil, errors.New("the room is full")
+	}
+	for id, player := range players {
+		if player.name == in.UserName {
+			inv.to = id
+			break
+		}
+	}
+	if inv.to == "" {
+		lock.Unlock()
+		return nil, errors.New("the player is not online")
+	}
+	if contains(room.roomPlayers, inv.to) {
+		lock.Unlock()
+		return nil, errors.New("the player is already in the room")
+	}
+	deliver(players[inv.to], &pb.GameMessage{Action: &pb.GameMessage_Invitation{Invitation: msg}, Sender: in.Uuid})
+	lock.Unlock()
+
+	invitationMu.Lock()
+	invitations[inv.id] = inv
+	invitationMu.Unlock()
+	logger.InfoContext(withRoom(withPlayer(ctx, in.Uuid), in.RoomID), "player invited", "invited", in.UserName)
+	return msg, nil
+}
+
+func (s *server) RespondInvitation(ctx context.Context, in *pb.InvitationResponse) (*pb.RoomID, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	invitationMu.Lock()
+	inv, ok := invitations[in.InvitationID]
+	if ok && inv.to == in.Uuid {
+		delete(invitations, in.InvitationID)
+	}
+	invitationMu.Unlock()
+	if !ok || inv.to != in.Uuid {
+		return nil, errors.New("no invitation with this ID")
+	}
+	if time.Now().After(inv.expiresAt) {
+		return nil, errors.New("the invitation has expired")
+	}
+
+	answer := &pb.GameMessage{
+		Action: &pb.GameMessage_InvitationAnswer{InvitationAnswer: &pb.InvitationAnswer{
+			InvitationID: inv.id, UserName: inv.toUserName, Accepted: in.Accept,
+		}},
+		Sender: in.Uuid,
+	}
+	if !in.Accept {
+		BroadcastToSpecificClient(inv.from, answer)
+		return &pb.RoomID{}, nil
+	}
+
+	// blocks still apply in private rooms, someone blocked may have joined since the invitation
+	blocked, err := blockedInRoom(in.Uuid, inv.roomID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, errors.New("cannot join this room")
+	}
+	lock.Lock()
+	room, ok := rooms[inv.roomID]
+	if !ok {
+		lock.Unlock()
+		return nil, errors.New("room does not exist")
+	}
+	if len(room.roomPlayers) >= int(room.maxPlayer) {
+		lock.Unlock()
+		return nil, errors.New("the room is full")
+	}
+	room.roomPlayers = append(room.roomPlayers, in.Uuid)
+	presenceChanged(in.Uuid)
+	deliver(players[inv.from], answer)
+	stake := room.stake
+	lock.Unlock()
+
+	logger.InfoContext(withRoom(withPlayer(ctx, in.Uuid), inv.roomID), "invitation accepted")
+	return &pb.RoomID{UniqueID: inv.roomID, Stake: stake}, nil
+}
+
+// evictExpiredInvitations -- drops invitations nobody answered in time, runs until the server shuts down
+func evictExpiredInvitations() {
+	for {
+		time.Sleep(invitationLifetime)
+		invitationMu.Lock()
+		now := time.Now()
+		for id, inv := range invitations {
+			if now.After(inv.expiresAt) {
+				delete(invitations, id)
+			}
+		}
+		invitationMu.Unlock()
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// reasons of cash ledger entries
+const (
+	cashMatchReward  = "match_reward"
+	cashPurchase     = "purchase"
+	cashRefund       = "refund"
+	cashAdminGrant   = "admin_grant"
+	cashSeasonReward = "season_reward"
+)
+
+const (
+	transactionsPageSize = 50
+	transactionsMaxPage  = 100
+)
+
+var errNotEnoughCash = errors.New("not enough cash")
+
+func (s *server) GetTransactions(_ context.Context, in *pb.TransactionsReq) (*pb.TransactionList, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	limit := int64(in.Limit)
+	if limit <= 0 {
+		limit = transactionsPageSize
+	}
+	if limit > transactionsMaxPage {
+		limit = transactionsMaxPage
+	}
+	filter := bson.M{"playerID": in.Uuid}
+	if in.Before > 0 {
+		filter["createdAt"] = bson.M{"$lt": time.Unix(in.Before, 0)}
+	}
+
+	collection, err := db.GetCashLedgerCollection()
+	if err != nil {
+		return nil, errors.New("[DB] cash ledger table err")
+	}
+	opt := options.Find().SetSort(bson.M{"createdAt": -1}).SetLimit(limit)
+	cursor, err := collection.Find(context.TODO(), filter, opt)
+	if err != nil {
+		return nil, errors.New("[DB] err while load transactions")
+	}
+	var entries []model.CashTransaction
+	if err := cursor.All(context.TODO(), &entries); err != nil {
+		return nil, errors.New("[DB] err while load transactions")
+	}
+
+	result := &pb.TransactionList{}
+	for _, entry := range entries {
+		result.Transactions = append(result.Transactions, &pb.CashTransaction{
+			TransactionID: entry.TransactionID,
+			Amount:        int32(entry.Amount),
+			Reason:        entry.Reason,
+			Balance:       int32(entry.Balance),
+			Reference:     entry.Reference,
+			CreatedAt:     entry.CreatedAt.Unix(),
+		})
+	}
+	return result, nil
+}
+
+func (s *server) GrantCash(ctx context.Context, in *pb.GrantCashReq) (*pb.CashBalance, error) {
+	admin, err := requireAdmin(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	reason := in.Reason
+	if reason == "" {
+		reason = cashAdminGrant
+	}
+	if reason != cashAdminGrant && reason != cashSeasonReward {
+		return nil, errors.New("reason must be admin_grant or season_reward")
+	}
+	if in.Amount == 0 {
+		return nil, errors.New("amount cannot be 0")
+	}
+
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return nil, errors.New("[DB] user table err")
+	}
+	var target model.User
+	if err := collection.FindOne(context.TODO(), bson.M{"userName": in.UserName}).Decode(&target); err != nil {
+		return nil, errors.New("no player with this user name")
+	}
+	balance, err := applyCashChange(target.PlayerID, int(in.Amount), reason, "by "+admin.UserName)
+	if err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "cash granted", "target", in.UserName, "amount", in.Amount, "reason", reason)
+	return &pb.CashBalance{Cash: int32(balance)}, nil
+}
+
+// applyCashChange -- moves the balance with one conditional $inc so it never goes negative, then appends
+// the ledger entry and returns the new balance
+func applyCashChange(playerID string, amount int, reason string, reference string) (int, error) {
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return 0, errors.New("[DB] user table err")
+	}
+	filter := bson.M{"playerID": playerID}
+	if amount < 0 {
+		filter["cash"] = bson.M{"$gte": -amount}
+	}
+	update := bson.M{"$inc": bson.M{"cash": amount}}
+	var user model.User
+	err = collection.FindOneAndUpdate(context.TODO(), filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&user)
+	if err == mongo.ErrNoDocuments {
+		if amount < 0 {
+			return 0, errNotEnoughCash
+		}
+		return 0, errors.New("no record of user in DB")
+	}
+	if err != nil {
+		return 0, errors.New("[DB] cannot update cash")
+	}
+
+	entry := &model.CashTransaction{
+		TransactionID: uuid.New().String(),
+		PlayerID:      playerID,
+		Amount:        amount,
+		Reason:        reason,
+		Balance:       user.Cash,
+		Reference:     reference,
+		CreatedAt:     time.Now(),
+	}
+	ledger, err := db.GetCashLedgerCollection()
+	if err == nil {
+		_, err = ledger.InsertOne(context.TODO(), entry)
+	}
+	if err != nil {
+		// the balance already moved, the entry is logged so the ledger can be repaired by hand
+		logger.Error("cash ledger entry not stored", "player", playerID, "amount", amount, "reason", reason,
+			"balance", user.Cash, "reference", reference, "transactionID", entry.TransactionID, "error", err)
+	}
+	return user.Cash, nil
+}
+
+func skinReference(skinType int32, skinID int32) string {
+	return "skin " + strconv.Itoa(int(skinType)) + ":" + strconv.Itoa(int(skinID))
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *server) EquipSkin(ctx context.Context, in *pb.EquipSkinReq) (*pb.Loadout, error) {
+	userName, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	def, ok := itemTypes[in.SkinType]
+	if !ok {
+		return nil, errors.New("unknown skin type")
+	}
+	if def.equipSlot == "" {
+		return nil, errors.New("this item type cannot be equipped")
+	}
+	field := def.equipSlot
+	if in.SkinID != 0 {
+		owned, err := ownsItem(in.Uuid, in.SkinType, in.SkinID)
+		if err != nil {
+			return nil, err
+		}
+		if !owned {
+			return nil, errors.New("skin not owned")
+		}
+	}
+
+	collection, err := db.GetLoadoutCollection()
+	if err != nil {
+		return nil, errors.New("[DB] loadout table err")
+	}
+	var loadout model.Loadout
+	opt := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err = collection.FindOneAndUpdate(context.TODO(), bson.M{"playerID": in.Uuid}, bson.M{"$set": bson.M{field: in.SkinID}}, opt).Decode(&loadout)
+	if err != nil {
+		return nil, errors.New("[DB] cannot update loadout")
+	}
+
+	lock.Lock()
+	if player, ok := players[in.Uuid]; ok {
+		player.loadout = loadout
+	}
+	lock.Unlock()
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "skin equipped", "skinType", in.SkinType, "skinID", in.SkinID)
+	return loadoutMessage(userName, &loadout), nil
+}
+
+// loadLoadout -- fills the session's equipped skins from the stored loadout, called after login
+func loadLoadout(player *Player) {
+	collection, err := db.GetLoadoutCollection()
+	if err != nil {
+		logger.Warn("load loadout failed", "player", player.uuid, "error", err)
+		return
+	}
+	var loadout model.Loadout
+	if err := collection.FindOne(context.TODO(), bson.M{"playerID": player.uuid}).Decode(&loadout); err != nil {
+		return
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	player.loadout = loadout
+}
+
+func loadoutMessage(userName string, loadout *model.Loadout) *pb.Loadout {
+	return &pb.Loadout{
+		UserName:    userName,
+		PuckSkin:    loadout.PuckSkin,
+		StrikerSkin: loadout.StrikerSkin,
+		TableSkin:   loadout.TableSkin,
+	}
+}
+
+// withLoadouts -- a copy of the game state carrying the loadout of every room member, the caller holds the lock
+func withLoadouts(room *Room, msg *pb.GameMessage) *pb.GameMessage {
+	state := proto.Clone(msg.GetGameState()).(*pb.GameState)
+	state.Loadouts = nil
+	for _, id := range room.roomPlayers {
+		if player, ok := players[id]; ok {
+			state.Loadouts = append(state.Loadouts, loadoutMessage(player.name, &player.loadout))
+		}
+	}
+	return &pb.GameMessage{Action: &pb.GameMessage_GameState{GameState: state}, Sender: msg.Sender}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// logLevel -- adjustable at runtime through the /loglevel HTTP endpoint, starts from LOG_LEVEL (default info)
+var logLevel = new(slog.LevelVar)
+
+var logger = slog.New(contextHandler{slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: logLevel})})
+
+type logFieldsKey struct{}
+
+// contextHandler -- adds the room, player and rpc fields stored in the context to every record
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if attrs, ok := ctx.Value(logFieldsKey{}).([]slog.Attr); ok {
+		r.AddAttrs(attrs...)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+func init() {
+	if level := os.Getenv("LOG_LEVEL"); level != "" {
+		if err := logLevel.UnmarshalText([]byte(level)); err != nil {
+			logger.Warn("invalid LOG_LEVEL, using info", "value", level)
+		}
+	}
+}
+
+// withLogFields -- returns a context whose log records carry the given fields
+func withLogFields(ctx context.Context, attrs ...slog.Attr) context.Context {
+	existing, _ := ctx.Value(logFieldsKey{}).([]slog.Attr)
+	merged := make([]slog.Attr, 0, len(existing)+len(attrs))
+	merged = append(merged, existing...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, logFieldsKey{}, merged)
+}
+
+func withPlayer(ctx context.Context, playerID string) context.Context {
+	return withLogFields(ctx, slog.String("player", playerID))
+}
+
+func withRoom(ctx context.Context, roomID string) context.Context {
+	return withLogFields(ctx, slog.String("room", roomID))
+}
+
+func unaryLoggingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx = withLogFields(ctx, slog.String("rpc", info.FullMethod))
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	if err != nil {
+		logger.WarnContext(ctx, "request failed", "error", err, "duration", time.Since(start))
+	} else {
+		logger.DebugContext(ctx, "request handled", "duration", time.Since(start))
+	}
+	return resp, err
+}
+
+// loggingStream -- server stream carrying the rpc field in its context
+type loggingStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *loggingStream) Context() context.Context {
+	return s.ctx
+}
+
+func streamLoggingInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := withLogFields(ss.Context(), slog.String("rpc", info.FullMethod))
+	err := handler(srv, &loggingStream{ServerStream: ss, ctx: ctx})
+	if err != nil {
+		logger.WarnContext(ctx, "stream closed with error", "error", err)
+	}
+	return err
+}
+
+// handleLogLevel -- GET returns the current level, PUT or POST with ?level=debug|info|warn|error changes it
+func handleLogLevel(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut, http.MethodPost:
+		if err := logLevel.UnmarshalText([]byte(r.URL.Query().Get("level"))); err != nil {
+			http.Error(w, "unknown level", http.StatusBadRequest)
+			return
+		}
+		logger.Info("log level changed", "level", logLevel.Level().String())
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Write([]byte(logLevel.Level().String() + "\n"))
+}
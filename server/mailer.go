@@ -0,0 +1,87 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Mailer -- sends account emails such as password reset links
+type Mailer interface {
+	Send(to string, subject string, body string) error
+}
+
+// SMTPMailer -- delivers through an SMTP relay, auth is skipped when Username is empty
+type SMTPMailer struct {
+	Addr     string // host:port
+	From     string
+	Username string
+	Password string
+}
+
+func (m *SMTPMailer) Send(to string, subject string, body string) error {
+	var auth smtp.Auth
+	if m.Username != "" {
+		host := strings.Split(m.Addr, ":")[0]
+		auth = smtp.PlainAuth("", m.Username, m.Password, host)
+	}
+	msg := "From: " + m.From + "\r\n" +
+		"To: " + to + "\r\n" +
+		"Subject: " + subject + "\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n" + body + "\r\n"
+	return smtp.SendMail(m.Addr, auth, m.From, []string{to}, []byte(msg))
+}
+
+// LocalMailer -- for development and tests, writes each mail to a file in Dir or only logs it when Dir is empty
+type LocalMailer struct {
+	Dir string
+}
+
+func (m *LocalMailer) Send(to string, subject string, body string) error {
+	if m.Dir == "" {
+		logger.Info("mail not sent, local mailer", "to", to, "subject", subject, "body", body)
+		return nil
+	}
+	if err := os.MkdirAll(m.Dir, 0o755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), strings.ReplaceAll(to, "@", "_at_"))
+	content := "To: " + to + "\nSubject: " + subject + "\n\n" + body + "\n"
+	return os.WriteFile(filepath.Join(m.Dir, name), []byte(content), 0o600)
+}
+
+// noMailer -- used when nothing is configured, mails fail instead of leaking reset codes into the logs
+type noMailer struct{}
+
+func (noMailer) Send(string, string, string) error {
+	return errors.New("no mailer configured, set SMTP_ADDR")
+}
+
+var mailer = newMailer()
+
+// newMailer -- SMTP when SMTP_ADDR is set; the local mailer only when asked for with MAIL_DIR or
+// MAIL_LOG=true, which logs mail bodies and is meant for development
+func newMailer() Mailer {
+	if addr := os.Getenv("SMTP_ADDR"); addr != "" {
+		return &SMTPMailer{
+			Addr:     addr,
+			From:     os.Getenv("SMTP_FROM"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+		}
+	}
+	if dir := os.Getenv("MAIL_DIR"); dir != "" {
+		return &LocalMailer{Dir: dir}
+	}
+	if os.Getenv("MAIL_LOG") == "true" {
+		logger.Warn("MAIL_LOG is set, mail bodies including reset codes are logged")
+		return &LocalMailer{}
+	}
+	logger.Warn("no mailer configured, password reset mails will not be sent")
+	return noMailer{}
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// cash paid to every player of a finalized match, by result
+const (
+	matchRewardWin  = 50
+	matchRewardDraw = 20
+	matchRewardLoss = 10
+)
+
+// roomMatch -- a match as the server saw it, teams are fixed when the host starts it
+type roomMatch struct {
+	team1      []string          // player IDs at even positions
+	team2      []string          // player IDs at odd positions
+	userNames  map[string]string // user name of each player when the match started
+	scoreTeam1 int32
+	scoreTeam2 int32
+	ended      bool // the host ended it while the room was playing
+	complete   bool // both teams had players and all of them were logged in at the start
+	finalized  bool // its record was stored, set under the lock
+}
+
+// acceptGameState -- only the host starts, scores and ends a match, false when the state must be dropped.
+// The scores of msg are replaced with the ones tracked by the server.
+func acceptGameState(msg *pb.GameMessage) bool {
+	state := msg.GetGameState()
+	lock.Lock()
+	defer lock.Unlock()
+	room, ok := rooms[state.RoomID]
+	if !ok || msg.Sender != room.host {
+		return false
+	}
+
+	switch {
+	case state.IsPlaying == 1 && room.state != roomPlaying:
+		if room.wager == nil {
+			// a wager start being escrowed keeps the teams it took
+			room.match = newRoomMatch(room.roomPlayers)
+		}
+		state.ScoreTeam1, state.ScoreTeam2 = 0, 0
+	case room.state == roomPlaying && room.match != nil:
+		room.match.track(state, room.maxScore)
+		if state.IsPlaying != 1 {
+			room.match.ended = true
+		}
+	}
+	return true
+}
+
+// newRoomMatch -- the caller holds the lock
+func newRoomMatch(roomPlayers []string) *roomMatch {
+	match := &roomMatch{userNames: make(map[string]string), complete: len(roomPlayers) >= 2}
+	for i, id := range roomPlayers {
+		if player, ok := players[id]; ok {
+			match.userNames[id] = player.name
+		} else {
+			match.complete = false
+		}
+		if i%2 == 0 {
+			match.team1 = append(match.team1, id)
+		} else {
+			match.team2 = append(match.team2, id)
+		}
+	}
+	return match
+}
+
+// track -- takes a reported score only one goal at a time and not past the target score,
+// then writes the tracked score back into the state
+func (m *roomMatch) track(state *pb.GameState, maxScore int32) {
+	goals1 := state.ScoreTeam1 - m.scoreTeam1
+	goals2 := state.ScoreTeam2 - m.scoreTeam2
+	finished := maxScore > 0 && (m.scoreTeam1 >= maxScore || m.scoreTeam2 >= maxScore)
+	if !finished && goals1 >= 0 && goals2 >= 0 && goals1+goals2 <= 1 {
+		m.scoreTeam1, m.scoreTeam2 = state.ScoreTeam1, state.ScoreTeam2
+	}
+	state.ScoreTeam1, state.ScoreTeam2 = m.scoreTeam1, m.scoreTeam2
+}
+
+// matchResult -- one player's side of a finalized match
+type matchResult struct {
+	recordID     string
+	playerID     string
+	userName     string
+	goalsFor     int
+	goalsAgainst int
+	teamSize     int // players on this side
+	opponentSize int // players on the other side
+}
+
+func (m matchResult) won() bool   { return m.goalsFor > m.goalsAgainst }
+func (m matchResult) lost() bool  { return m.goalsFor < m.goalsAgainst }
+func (m matchResult) is2v2() bool { return m.teamSize == 2 && m.opponentSize == 2 }
+
+// endedMatch -- hands the room's ended match to its host once, its record and rewards are based on it
+func endedMatch(playerID string, token string, roomID string) (*roomMatch, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	player, ok := players[playerID]
+	if !ok || !tokenMatches(player, token) {
+		return nil, errors.New("no active session for this player")
+	}
+	room, ok := rooms[roomID]
+	if !ok || room.host != playerID {
+		return nil, errors.New("only the host of the room can store its match")
+	}
+	if room.match == nil || !room.match.ended {
+		return nil, errors.New("the room has no ended match")
+	}
+	if room.match.finalized {
+		return nil, errors.New("the match of this room is already stored")
+	}
+	if !room.match.complete {
+		// a match without a real opponent earns nothing
+		return nil, errors.New("only matches between logged-in players on both sides are stored")
+	}
+	room.match.finalized = true
+	return room.match, nil
+}
+
+// reopenMatch -- lets the host store the match again after its record could not be stored
+func reopenMatch(match *roomMatch) {
+	lock.Lock()
+	match.finalized = false
+	lock.Unlock()
+}
+
+// finalizeMatch -- updates the stats of every player of a stored match and evaluates what depends on them
+func finalizeMatch(recordID string, match *roomMatch) {
+	sides := []struct {
+		team         []string
+		opponents    []string
+		goalsFor     int
+		goalsAgainst int
+	}{
+		{match.team1, match.team2, int(match.scoreTeam1), int(match.scoreTeam2)},
+		{match.team2, match.team1, int(match.scoreTeam2), int(match.scoreTeam1)},
+	}
+	for _, side := range sides {
+		for _, playerID := range side.team {
+			result := matchResult{recordID: recordID, playerID: playerID, userName: match.userNames[playerID], goalsFor: side.goalsFor, goalsAgainst: side.goalsAgainst, teamSize: len(side.team), opponentSize: len(side.opponents)}
+			stats, err := updateStats(result)
+			if err != nil {
+				logger.Error("player stats not updated", "record", recordID, "player", playerID, "error", err)
+				continue
+			}
+			payMatchReward(result)
+			evaluateAchievements(result, stats)
+			progressMissions(result, time.Now())
+		}
+	}
+}
+
+func payMatchReward(result matchResult) {
+	reward := matchRewardDraw
+	switch {
+	case result.won():
+		reward = matchRewardWin
+	case result.lost():
+		reward = matchRewardLoss
+	}
+	if _, err := applyCashChange(result.playerID, reward, cashMatchReward, "record "+result.recordID); err != nil {
+		logger.Error("match reward not paid", "record", result.recordID, "player", result.playerID, "amount", reward, "error", err)
+	}
+}
+
+func playerIDByUserName(userName string) (string, error) {
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return "", errors.New("[DB] user table err")
+	}
+	var user model.User
+	if err := collection.FindOne(context.TODO(), bson.M{"userName": userName}).Decode(&user); err != nil {
+		return "", errors.New("no player with this user name")
+	}
+	return user.PlayerID, nil
+}
+
+// updateStats -- adds the match to the player's totals and returns them
+func updateStats(result matchResult) (*model.PlayerStats, error) {
+	collection, err := db.GetPlayerStatsCollection()
+	if err != nil {
+		return nil, errors.New("[DB] player stats table err")
+	}
+	inc := bson.M{"matchesPlayed": 1, "goalsFor": result.goalsFor, "goalsAgainst": result.goalsAgainst}
+	update := bson.M{"$inc": inc}
+	switch {
+	case result.won():
+		inc["wins"] = 1
+		inc["winStreak"] = 1
+	case result.lost():
+		inc["losses"] = 1
+		update["$set"] = bson.M{"winStreak": 0}
+	default:
+		inc["draws"] = 1
+		update["$set"] = bson.M{"winStreak": 0}
+	}
+
+	var stats model.PlayerStats
+	opt := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	err = collection.FindOneAndUpdate(context.TODO(), bson.M{"playerID": result.playerID}, update, opt).Decode(&stats)
+	if err != nil {
+		return nil, errors.New("[DB] cannot update player stats")
+	}
+	if stats.WinStreak > stats.BestWinStreak {
+		_, err = collection.UpdateOne(context.TODO(), bson.M{"playerID": result.playerID}, bson.M{"$max": bson.M{"bestWinStreak": stats.WinStreak}})
+		if err != nil {
+			return nil, errors.New("[DB] cannot update player stats")
+		}
+		stats.BestWinStreak = stats.WinStreak
+	}
+	return &stats, nil
+}
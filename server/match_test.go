@@ -0,0 +1,39 @@
+package main
+
+import (
+	"air-hockey-backend/pb"
+	"testing"
+)
+
+func TestRoomMatchTrack(t *testing.T) {
+	tests := []struct {
+		name           string
+		score1, score2 int32 // tracked before the state
+		state1, state2 int32 // reported by the host
+		maxScore       int32
+		want1, want2   int32
+	}{
+		{"first goal", 0, 0, 1, 0, 7, 1, 0},
+		{"goal for team 2", 3, 2, 3, 3, 7, 3, 3},
+		{"same score", 2, 2, 2, 2, 7, 2, 2},
+		{"two goals at once", 0, 0, 2, 0, 7, 0, 0},
+		{"one goal each at once", 1, 1, 2, 2, 7, 1, 1},
+		{"score going down", 3, 1, 2, 1, 7, 3, 1},
+		{"winning goal", 6, 4, 7, 4, 7, 7, 4},
+		{"goal after the target", 7, 4, 7, 5, 7, 7, 4},
+		{"no target score", 10, 0, 11, 0, 0, 11, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &roomMatch{scoreTeam1: tt.score1, scoreTeam2: tt.score2}
+			state := &pb.GameState{ScoreTeam1: tt.state1, ScoreTeam2: tt.state2}
+			m.track(state, tt.maxScore)
+			if m.scoreTeam1 != tt.want1 || m.scoreTeam2 != tt.want2 {
+				t.Errorf("tracked %d-%d, want %d-%d", m.scoreTeam1, m.scoreTeam2, tt.want1, tt.want2)
+			}
+			if state.ScoreTeam1 != tt.want1 || state.ScoreTeam2 != tt.want2 {
+				t.Errorf("relayed %d-%d, want %d-%d", state.ScoreTeam1, state.ScoreTeam2, tt.want1, tt.want2)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"air-hockey-backend/pb"
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.mongodb.org/mongo-driver/event"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	grpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "airhockey_grpc_requests_total",
+		Help: "Number of gRPC requests handled, by method and status code.",
+	}, []string{"method", "code"})
+
+	grpcLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "airhockey_grpc_request_duration_seconds",
+		Help:    "Time spent handling gRPC requests, by method. Streams are observed when they end.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	streamMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "airhockey_game_stream_messages_total",
+		Help: "Messages received through GameStream, by action.",
+	}, []string{"action"})
+
+	droppedFrames = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "airhockey_dropped_frames_total",
+		Help: "Messages dropped because the receiving player's channel was full, by action.",
+	}, []string{"action"})
+
+	rateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "airhockey_rate_limited_total",
+		Help: "Requests and stream messages rejected by rate limiting, by scope (ip, player, stream).",
+	}, []string{"scope"})
+
+	mongoLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "airhockey_mongo_operation_duration_seconds",
+		Help:    "Latency of MongoDB commands, by command name and outcome.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"command", "outcome"})
+
+	activePlayers = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "airhockey_active_players",
+		Help: "Players currently registered on the server.",
+	}, func() float64 {
+		lock.RLock()
+		defer lock.RUnlock()
+		return float64(len(players))
+	})
+
+	broadcastQueueDepth = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "airhockey_broadcast_queue_depth",
+		Help: "Messages waiting in player channels to be sent to clients.",
+	}, func() float64 {
+		lock.RLock()
+		defer lock.RUnlock()
+		depth := 0
+		for _, p := range players {
+			depth += len(p.channel)
+		}
+		return float64(depth)
+	})
+)
+
+func init() {
+	prometheus.MustRegister(grpcRequests, grpcLatency, streamMessages, droppedFrames, rateLimited, mongoLatency,
+		activePlayers, broadcastQueueDepth)
+	for _, state := range []string{roomWaiting, roomPlaying} {
+		prometheus.MustRegister(activeRoomsGauge(state))
+	}
+}
+
+// activeRoomsGauge -- one gauge per room state, computed on scrape
+func activeRoomsGauge(state string) prometheus.GaugeFunc {
+	return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "airhockey_active_rooms",
+		Help:        "Rooms currently open on the server, by state.",
+		ConstLabels: prometheus.Labels{"state": state},
+	}, func() float64 {
+		lock.RLock()
+		defer lock.RUnlock()
+		count := 0
+		for _, singleRoom := range rooms {
+			if singleRoom.state == state {
+				count++
+			}
+		}
+		return float64(count)
+	})
+}
+
+func unaryMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	grpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+	grpcLatency.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+	return resp, err
+}
+
+func streamMetricsInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	grpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+	grpcLatency.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+	return err
+}
+
+// mongoMonitor -- records the latency of every command sent to MongoDB
+func mongoMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(_ context.Context, evt *event.CommandSucceededEvent) {
+			mongoLatency.WithLabelValues(evt.CommandName, "success").Observe(time.Duration(evt.DurationNanos).Seconds())
+		},
+		Failed: func(_ context.Context, evt *event.CommandFailedEvent) {
+			mongoLatency.WithLabelValues(evt.CommandName, "failure").Observe(time.Duration(evt.DurationNanos).Seconds())
+		},
+	}
+}
+
+// actionName -- label used for GameMessage metrics
+func actionName(msg *pb.GameMessage) string {
+	switch msg.GetAction().(type) {
+	case *pb.GameMessage_PlayerInput:
+		return "player_input"
+	case *pb.GameMessage_EntityState:
+		return "entity_state"
+	case *pb.GameMessage_GameState:
+		return "game_state"
+	case *pb.GameMessage_Empty:
+		return "empty"
+	case *pb.GameMessage_SessionEvent:
+		return "session_event"
+	case *pb.GameMessage_Invitation:
+		return "invitation"
+	case *pb.GameMessage_InvitationAnswer:
+		return "invitation_answer"
+	case *pb.GameMessage_ChatMessage:
+		return "chat"
+	case *pb.GameMessage_Emote:
+		return "emote"
+	case *pb.GameMessage_ModerationNotice:
+		return "moderation_notice"
+	case *pb.GameMessage_AchievementUnlocked:
+		return "achievement_unlocked"
+	case *pb.GameMessage_Gift:
+		return "gift"
+	}
+	return "none"
+}
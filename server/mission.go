@@ -0,0 +1,259 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	cashMission = "mission"
+
+	missionDaily  = "daily"
+	missionWeekly = "weekly"
+
+	dailyMissionCount  = 3 // missions handed to each player per day
+	weeklyMissionCount = 2 // and per week
+)
+
+// missionDef -- one mission of a pool, progress is what a finalized match adds towards target
+type missionDef struct {
+	id          string
+	name        string
+	description string
+	target      int
+	rewardCash  int
+	progress    func(match matchResult) int
+}
+
+// dailyMissions, weeklyMissions -- the pools players' missions are drawn from, IDs are stored and must not change
+var dailyMissions = []missionDef{
+	{
+		id: "daily_goals_15", name: "Sharpshooter", description: "Score 15 goals today",
+		target: 15, rewardCash: 150,
+		progress: func(match matchResult) int { return match.goalsFor },
+	},
+	{
+		id: "daily_play_5", name: "Warm Up", description: "Play 5 matches today",
+		target: 5, rewardCash: 100,
+		progress: func(_ matchResult) int { return 1 },
+	},
+	{
+		id: "daily_win_2", name: "Double Up", description: "Win 2 matches today",
+		target: 2, rewardCash: 150,
+		progress: func(match matchResult) int { return boolCount(match.won()) },
+	},
+	{
+		id: "daily_clean_sheet", name: "Wall", description: "Win a match without conceding today",
+		target: 1, rewardCash: 200,
+		progress: func(match matchResult) int { return boolCount(match.won() && match.goalsAgainst == 0) },
+	},
+	{
+		id: "daily_play_2v2_2", name: "Team Player", description: "Play 2 2v2 matches today",
+		target: 2, rewardCash: 100,
+		progress: func(match matchResult) int { return boolCount(match.is2v2()) },
+	},
+}
+
+var weeklyMissions = []missionDef{
+	{
+		id: "weekly_win_2v2_3", name: "Dynamic Duo", description: "Win 3 2v2 matches this week",
+		target: 3, rewardCash: 500,
+		progress: func(match matchResult) int { return boolCount(match.won() && match.is2v2()) },
+	},
+	{
+		id: "weekly_goals_100", name: "Goal Machine", description: "Score 100 goals this week",
+		target: 100, rewardCash: 600,
+		progress: func(match matchResult) int { return match.goalsFor },
+	},
+	{
+		id: "weekly_win_10", name: "Champion", description: "Win 10 matches this week",
+		target: 10, rewardCash: 700,
+		progress: func(match matchResult) int { return boolCount(match.won()) },
+	},
+	{
+		id: "weekly_play_25", name: "Regular", description: "Play 25 matches this week",
+		target: 25, rewardCash: 400,
+		progress: func(_ matchResult) int { return 1 },
+	},
+}
+
+func boolCount(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// assignedMission -- a mission drawn for a player in the current period
+type assignedMission struct {
+	def      *missionDef
+	kind     string // missionDaily or missionWeekly
+	period   string
+	resetsAt time.Time
+}
+
+// missionPeriods -- keys and ends of the current daily and weekly periods, in the reward time zone
+func missionPeriods(now time.Time) (day string, dayEnd time.Time, week string, weekEnd time.Time) {
+	local := now.In(rewardLocation)
+	year, month, date := local.Date()
+	midnight := time.Date(year, month, date, 0, 0, 0, 0, rewardLocation)
+	isoYear, isoWeek := local.ISOWeek()
+	daysToMonday := (8 - int(local.Weekday())) % 7
+	if daysToMonday == 0 {
+		daysToMonday = 7
+	}
+	return local.Format(dailyDayLayout), midnight.AddDate(0, 0, 1),
+		fmt.Sprintf("%d-W%02d", isoYear, isoWeek), midnight.AddDate(0, 0, daysToMonday)
+}
+
+// drawMissions -- picks count missions from the pool, the same for a player during a whole period
+func drawMissions(pool []missionDef, count int, playerID string, period string) []*missionDef {
+	hash := fnv.New64a()
+	hash.Write([]byte(playerID + "/" + period))
+	order := rand.New(rand.NewSource(int64(hash.Sum64()))).Perm(len(pool))
+	if count > len(order) {
+		count = len(order)
+	}
+	var picked []*missionDef
+	for _, i := range order[:count] {
+		picked = append(picked, &pool[i])
+	}
+	return picked
+}
+
+// playerMissions -- the player's daily then weekly missions of the current periods
+func playerMissions(playerID string, now time.Time) []assignedMission {
+	day, dayEnd, week, weekEnd := missionPeriods(now)
+	var missions []assignedMission
+	for _, def := range drawMissions(dailyMissions, dailyMissionCount, playerID, day) {
+		missions = append(missions, assignedMission{def: def, kind: missionDaily, period: day, resetsAt: dayEnd})
+	}
+	for _, def := range drawMissions(weeklyMissions, weeklyMissionCount, playerID, week) {
+		missions = append(missions, assignedMission{def: def, kind: missionWeekly, period: week, resetsAt: weekEnd})
+	}
+	return missions
+}
+
+func (s *server) GetMissions(_ context.Context, in *pb.MissionsReq) (*pb.MissionList, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	missions := playerMissions(in.Uuid, time.Now())
+	var periods []string
+	for _, mission := range missions {
+		periods = append(periods, mission.period)
+	}
+
+	collection, err := db.GetMissionCollection()
+	if err != nil {
+		return nil, errors.New("[DB] mission table err")
+	}
+	cursor, err := collection.Find(context.TODO(), bson.M{"playerID": in.Uuid, "period": bson.M{"$in": periods}})
+	if err != nil {
+		return nil, errors.New("[DB] err while load missions")
+	}
+	var stored []model.MissionProgress
+	if err := cursor.All(context.TODO(), &stored); err != nil {
+		return nil, errors.New("[DB] err while load missions")
+	}
+	progress := make(map[string]model.MissionProgress)
+	for _, entry := range stored {
+		progress[entry.MissionID+"/"+entry.Period] = entry
+	}
+
+	result := &pb.MissionList{}
+	for _, mission := range missions {
+		entry := progress[mission.def.id+"/"+mission.period]
+		result.Missions = append(result.Missions, &pb.Mission{
+			Id:          mission.def.id,
+			Name:        mission.def.name,
+			Description: mission.def.description,
+			Period:      mission.kind,
+			Target:      int32(mission.def.target),
+			Progress:    int32(entry.Progress),
+			RewardCash:  int32(mission.def.rewardCash),
+			Claimed:     entry.Claimed,
+			ResetsAt:    mission.resetsAt.Unix(),
+		})
+	}
+	return result, nil
+}
+
+func (s *server) ClaimMission(ctx context.Context, in *pb.ClaimMissionReq) (*pb.CashBalance, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	var mission *assignedMission
+	for _, assigned := range playerMissions(in.Uuid, time.Now()) {
+		if assigned.def.id == in.MissionID {
+			mission = &assigned
+			break
+		}
+	}
+	if mission == nil {
+		return nil, errors.New("no current mission with this ID")
+	}
+
+	collection, err := db.GetMissionCollection()
+	if err != nil {
+		return nil, errors.New("[DB] mission table err")
+	}
+	filter := bson.M{
+		"playerID":  in.Uuid,
+		"missionID": mission.def.id,
+		"period":    mission.period,
+		"progress":  bson.M{"$gte": mission.def.target},
+		"claimed":   false,
+	}
+	update := bson.M{"$set": bson.M{"claimed": true, "claimedAt": time.Now()}}
+	result, err := collection.UpdateOne(context.TODO(), filter, update)
+	if err != nil {
+		return nil, errors.New("[DB] cannot claim mission")
+	}
+	if result.ModifiedCount == 0 {
+		return nil, errors.New("the mission is not complete or already claimed")
+	}
+
+	cash, err := applyCashChange(in.Uuid, mission.def.rewardCash, cashMission, mission.def.id+" "+mission.period)
+	if err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "mission claimed", "mission", mission.def.id, "period", mission.period, "reward", mission.def.rewardCash)
+	return &pb.CashBalance{Cash: int32(cash)}, nil
+}
+
+// progressMissions -- adds a finalized match to the player's current missions, only server-verified matches get here
+func progressMissions(match matchResult, now time.Time) {
+	collection, err := db.GetMissionCollection()
+	if err != nil {
+		logger.Error("mission progress not stored", "player", match.playerID, "error", err)
+		return
+	}
+	for _, mission := range playerMissions(match.playerID, now) {
+		amount := mission.def.progress(match)
+		if amount <= 0 {
+			continue
+		}
+		key := bson.M{"playerID": match.playerID, "missionID": mission.def.id, "period": mission.period}
+		if _, err := collection.UpdateOne(context.TODO(), key, bson.M{"$setOnInsert": bson.M{"progress": 0, "claimed": false}}, options.Update().SetUpsert(true)); err != nil {
+			logger.Error("mission progress not stored", "player", match.playerID, "mission", mission.def.id, "error", err)
+			continue
+		}
+		// a record is counted once, even if its match is finalized again
+		filter := bson.M{"playerID": match.playerID, "missionID": mission.def.id, "period": mission.period, "matches": bson.M{"$ne": match.recordID}}
+		update := bson.M{"$inc": bson.M{"progress": amount}, "$push": bson.M{"matches": match.recordID}}
+		if _, err := collection.UpdateOne(context.TODO(), filter, update); err != nil {
+			logger.Error("mission progress not stored", "player", match.playerID, "mission", mission.def.id, "error", err)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMissionPeriods(t *testing.T) {
+	defer func(location *time.Location) { rewardLocation = location }(rewardLocation)
+	ict := time.FixedZone("ICT", 7*60*60)
+	tests := []struct {
+		name        string
+		location    *time.Location
+		now         time.Time
+		wantDay     string
+		wantDayEnd  time.Time
+		wantWeek    string
+		wantWeekEnd time.Time
+	}{
+		{"midweek", time.UTC, time.Date(2026, 10, 21, 15, 0, 0, 0, time.UTC),
+			"2026-10-21", time.Date(2026, 10, 22, 0, 0, 0, 0, time.UTC), "2026-W43", time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC)},
+		{"sunday", time.UTC, time.Date(2026, 10, 25, 23, 59, 0, 0, time.UTC),
+			"2026-10-25", time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC), "2026-W43", time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC)},
+		{"monday", time.UTC, time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC),
+			"2026-10-26", time.Date(2026, 10, 27, 0, 0, 0, 0, time.UTC), "2026-W44", time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)},
+		{"iso year", time.UTC, time.Date(2027, 1, 1, 12, 0, 0, 0, time.UTC),
+			"2027-01-01", time.Date(2027, 1, 2, 0, 0, 0, 0, time.UTC), "2026-W53", time.Date(2027, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{"reward timezone", ict, time.Date(2026, 10, 25, 18, 0, 0, 0, time.UTC),
+			"2026-10-26", time.Date(2026, 10, 27, 0, 0, 0, 0, ict), "2026-W44", time.Date(2026, 11, 2, 0, 0, 0, 0, ict)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rewardLocation = tt.location
+			day, dayEnd, week, weekEnd := missionPeriods(tt.now)
+			if day != tt.wantDay || !dayEnd.Equal(tt.wantDayEnd) {
+				t.Errorf("day = %s ending %v, want %s ending %v", day, dayEnd, tt.wantDay, tt.wantDayEnd)
+			}
+			if week != tt.wantWeek || !weekEnd.Equal(tt.wantWeekEnd) {
+				t.Errorf("week = %s ending %v, want %s ending %v", week, weekEnd, tt.wantWeek, tt.wantWeekEnd)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	adminRole            = "admin"
+	reportRecentRecords  = 5
+	reportDetailsMaxSize = 1000
+	listReportsLimit     = 100
+)
+
+func (s *server) ReportPlayer(ctx context.Context, in *pb.ReportRequest) (*pb.ReportID, error) {
+	reporter, err := sessionUserName(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if reporter == in.UserName {
+		return nil, errors.New("cannot report yourself")
+	}
+	exists, err := userExists(in.UserName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.New("no player with this user name")
+	}
+	records, err := recentRecords(in.UserName, reportRecentRecords)
+	if err != nil {
+		return nil, err
+	}
+	details := strings.TrimSpace(in.Details)
+	if len(details) > reportDetailsMaxSize {
+		details = details[:reportDetailsMaxSize]
+	}
+
+	report := &model.Report{
+		ReportID:         uuid.New().String(),
+		ReporterID:       in.Uuid,
+		ReporterUserName: reporter,
+		UserName:         in.UserName,
+		Reason:           int32(in.Reason),
+		RoomID:           in.RoomID,
+		Details:          details,
+		RecentRecords:    records,
+		CreatedAt:        time.Now(),
+	}
+	collection, err := db.GetReportCollection()
+	if err != nil {
+		return nil, errors.New("[DB] report table err")
+	}
+	if _, err := collection.InsertOne(context.TODO(), report); err != nil {
+		return nil, errors.New("[DB] cannot store report")
+	}
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "player reported", "reported", in.UserName, "reason", in.Reason.String())
+	return &pb.ReportID{ReportID: report.ReportID}, nil
+}
+
+func (s *server) ListReports(ctx context.Context, in *pb.ListReportsReq) (*pb.ReportList, error) {
+	if _, err := requireAdmin(in.Uuid, in.SessionToken); err != nil {
+		return nil, err
+	}
+	collection, err := db.GetReportCollection()
+	if err != nil {
+		return nil, errors.New("[DB] report table err")
+	}
+	filter := bson.M{"resolved": false}
+	if in.IncludeResolved {
+		filter = bson.M{}
+	}
+	opt := options.Find().SetSort(bson.M{"createdAt": 1}).SetLimit(listReportsLimit)
+	cursor, err := collection.Find(context.TODO(), filter, opt)
+	if err != nil {
+		return nil, errors.New("[DB] err while load reports")
+	}
+	var reports []model.Report
+	if err := cursor.All(context.TODO(), &reports); err != nil {
+		return nil, errors.New("[DB] err while load reports")
+	}
+
+	result := &pb.ReportList{}
+	for _, report := range reports {
+		out := &pb.Report{
+			ReportID:         report.ReportID,
+			ReporterUserName: report.ReporterUserName,
+			UserName:         report.UserName,
+			Reason:           pb.ReportReason(report.Reason),
+			RoomID:           report.RoomID,
+			Details:          report.Details,
+			CreatedAt:        report.CreatedAt.Unix(),
+			Resolved:         report.Resolved,
+		}
+		for _, record := range report.RecentRecords {
+			out.RecentRecords = append(out.RecentRecords, &pb.Record{
+				RecordTime: record.RecordTime,
+				Team1:      record.Team1,
+				Team2:      record.Team2,
+				MatchScore: []int32{int32(record.MatchScore[0]), int32(record.MatchScore[1])},
+			})
+		}
+		result.Reports = append(result.Reports, out)
+	}
+	return result, nil
+}
+
+func (s *server) ModeratePlayer(ctx context.Context, in *pb.ModerationRequest) (*pb.Empty, error) {
+	admin, err := requireAdmin(in.Uuid, in.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	duration := time.Duration(in.DurationMinutes) * time.Minute
+	if (in.Action == pb.ModerationAction_MUTE_CHAT || in.Action == pb.ModerationAction_TEMP_BAN) && duration <= 0 {
+		return nil, errors.New("a duration is required for this action")
+	}
+	until := time.Now().Add(duration)
+
+	var update bson.M
+	switch in.Action {
+	case pb.ModerationAction_WARN:
+		update = bson.M{"$inc": bson.M{"warnings": 1}}
+	case pb.ModerationAction_MUTE_CHAT:
+		update = bson.M{"$set": bson.M{"chatMutedUntil": until}}
+	case pb.ModerationAction_TEMP_BAN:
+		update = bson.M{"$set": bson.M{"bannedUntil": until, "banReason": in.Reason}}
+	case pb.ModerationAction_PERMANENT_BAN:
+		update = bson.M{"$set": bson.M{"permanentBan": true, "banReason": in.Reason}}
+	default:
+		return nil, errors.New("unknown moderation action")
+	}
+	if in.ReportID != "" {
+		if err := checkReport(in.ReportID, in.UserName); err != nil {
+			return nil, err
+		}
+	}
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return nil, errors.New("[DB] user table err")
+	}
+	var target model.User
+	err = collection.FindOneAndUpdate(context.TODO(), bson.M{"userName": in.UserName}, update).Decode(&target)
+	if err != nil {
+		return nil, errors.New("no player with this user name")
+	}
+
+	// the sanction is stored, the live session must follow even if the report cannot be closed
+	if in.ReportID != "" {
+		if err := resolveReport(in.ReportID, in.Action, admin.UserName); err != nil {
+			logger.ErrorContext(withPlayer(ctx, in.Uuid), "report not resolved after moderation action", "report", in.ReportID, "error", err)
+		}
+	}
+
+	notice := &pb.ModerationNotice{Action: in.Action, Reason: in.Reason}
+	if in.Action == pb.ModerationAction_MUTE_CHAT || in.Action == pb.ModerationAction_TEMP_BAN {
+		notice.Until = until.Unix()
+	}
+	applySanction(target.PlayerID, notice, until)
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "moderation action", "target", in.UserName, "action", in.Action.String(), "reason", in.Reason)
+	return &pb.Empty{}, nil
+}
+
+// requireAdmin -- the session's user when it has the admin role
+func requireAdmin(playerID string, token string) (*model.User, error) {
+	if !validSession(playerID, token) {
+		return nil, errors.New("no active session for this player")
+	}
+	collection, err := db.GetUserCollection()
+	if err != nil {
+		return nil, errors.New("[DB] user table err")
+	}
+	var user model.User
+	err = collection.FindOne(context.TODO(), bson.M{"playerID": playerID}).Decode(&user)
+	if err != nil || user.Role != adminRole {
+		return nil, errors.New("admin only")
+	}
+	return &user, nil
+}
+
+// recentRecords -- the player's last matches, newest first
+func recentRecords(userName string, limit int64) ([]model.Record, error) {
+	collection, err := db.GetRecordCollection()
+	if err != nil {
+		return nil, errors.New("[DB] connection err")
+	}
+	filter := bson.M{"$or": []bson.M{{"team1": userName}, {"team2": userName}}}
+	opt := options.Find().SetSort(bson.M{"_id": -1}).SetLimit(limit)
+	cursor, err := collection.Find(context.TODO(), filter, opt)
+	if err != nil {
+		return nil, errors.New("[DB] err while load records")
+	}
+	var records []model.Record
+	if err := cursor.All(context.TODO(), &records); err != nil {
+		return nil, errors.New("[DB] err while load records")
+	}
+	return records, nil
+}
+
+// checkReport -- an error unless the report is open and about the sanctioned player
+func checkReport(reportID string, userName string) error {
+	collection, err := db.GetReportCollection()
+	if err != nil {
+		return errors.New("[DB] report table err")
+	}
+	var report model.Report
+	err = collection.FindOne(context.TODO(), bson.M{"reportID": reportID}).Decode(&report)
+	if err == mongo.ErrNoDocuments {
+		return errors.New("no report with this ID")
+	}
+	if err != nil {
+		return errors.New("[DB] err while load report")
+	}
+	if report.Resolved {
+		return errors.New("report already resolved")
+	}
+	if report.UserName != userName {
+		return errors.New("the report is about another player")
+	}
+	return nil
+}
+
+func resolveReport(reportID string, action pb.ModerationAction, admin string) error {
+	collection, err := db.GetReportCollection()
+	if err != nil {
+		return errors.New("[DB] report table err")
+	}
+	update := bson.M{"$set": bson.M{"resolved": true, "action": int32(action), "resolvedBy": admin, "resolvedAt": time.Now()}}
+	result, err := collection.UpdateOne(context.TODO(), bson.M{"reportID": reportID}, update)
+	if err != nil {
+		return errors.New("[DB] cannot resolve report")
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("no report with this ID")
+	}
+	return nil
+}
+
+// applySanction -- tells an online player and enforces mutes and bans on the live session
+func applySanction(playerID string, notice *pb.ModerationNotice, until time.Time) {
+	lock.Lock()
+	defer lock.Unlock()
+	player, ok := players[playerID]
+	if !ok {
+		return
+	}
+	deliver(player, &pb.GameMessage{Action: &pb.GameMessage_ModerationNotice{ModerationNotice: notice}})
+	switch notice.Action {
+	case pb.ModerationAction_MUTE_CHAT:
+		player.chatMutedUntil = until
+	case pb.ModerationAction_TEMP_BAN, pb.ModerationAction_PERMANENT_BAN:
+		delete(players, playerID)
+		kickSession(player, "banned: "+notice.Reason)
+		presenceChanged(playerID)
+	}
+}
+
+// checkBan -- refuses logins of banned accounts
+func checkBan(user *model.User) error {
+	if user.PermanentBan {
+		return errors.New("this account is banned: " + user.BanReason)
+	}
+	if time.Now().Before(user.BannedUntil) {
+		return errors.New("this account is banned until " + user.BannedUntil.UTC().Format(time.RFC3339) + ": " + user.BanReason)
+	}
+	return nil
+}
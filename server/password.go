@@ -0,0 +1,122 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordPolicy -- rules a new password must follow
+type PasswordPolicy struct {
+	MinLength     int
+	MaxLength     int // bcrypt ignores everything after 72 bytes
+	RequireLetter bool
+	RequireDigit  bool
+	RequireSymbol bool
+}
+
+// passwordPolicy -- PASSWORD_MIN_LENGTH, PASSWORD_MAX_LENGTH and PASSWORD_REQUIRE_LETTER/DIGIT/SYMBOL override the defaults
+var passwordPolicy = PasswordPolicy{
+	MinLength:     8,
+	MaxLength:     72,
+	RequireLetter: true,
+	RequireDigit:  true,
+}
+
+// passwordHashCost -- bcrypt cost for new hashes, stored hashes below it are upgraded on login (PASSWORD_HASH_COST overrides)
+var passwordHashCost = 12
+
+func init() {
+	if value := os.Getenv("PASSWORD_HASH_COST"); value != "" {
+		cost, err := strconv.Atoi(value)
+		if err != nil || cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+			logger.Warn("invalid PASSWORD_HASH_COST, using default", "value", value, "default", passwordHashCost)
+		} else {
+			passwordHashCost = cost
+		}
+	}
+	passwordPolicy.MinLength = envLength("PASSWORD_MIN_LENGTH", passwordPolicy.MinLength)
+	passwordPolicy.MaxLength = envLength("PASSWORD_MAX_LENGTH", passwordPolicy.MaxLength)
+	if passwordPolicy.MinLength > passwordPolicy.MaxLength {
+		logger.Warn("PASSWORD_MIN_LENGTH is above PASSWORD_MAX_LENGTH, using both defaults", "min", passwordPolicy.MinLength, "max", passwordPolicy.MaxLength)
+		passwordPolicy.MinLength, passwordPolicy.MaxLength = 8, 72
+	}
+	passwordPolicy.RequireLetter = envFlag("PASSWORD_REQUIRE_LETTER", passwordPolicy.RequireLetter)
+	passwordPolicy.RequireDigit = envFlag("PASSWORD_REQUIRE_DIGIT", passwordPolicy.RequireDigit)
+	passwordPolicy.RequireSymbol = envFlag("PASSWORD_REQUIRE_SYMBOL", passwordPolicy.RequireSymbol)
+}
+
+// envLength -- a password length from the environment, bcrypt caps it at 72 bytes
+func envLength(name string, fallback int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+	length, err := strconv.Atoi(value)
+	if err != nil || length < 1 || length > 72 {
+		logger.Warn("invalid "+name+", using default", "value", value, "default", fallback)
+		return fallback
+	}
+	return length
+}
+
+func envFlag(name string, fallback bool) bool {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+	flag, err := strconv.ParseBool(value)
+	if err != nil {
+		logger.Warn("invalid "+name+", using default", "value", value, "default", fallback)
+		return fallback
+	}
+	return flag
+}
+
+func (p PasswordPolicy) Validate(password string) error {
+	if utf8.RuneCountInString(password) < p.MinLength {
+		return errors.New("password must be at least " + strconv.Itoa(p.MinLength) + " characters")
+	}
+	if len(password) > p.MaxLength {
+		return errors.New("password must be at most " + strconv.Itoa(p.MaxLength) + " bytes")
+	}
+	var hasLetter, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireLetter && !hasLetter {
+		return errors.New("password must contain a letter")
+	}
+	if p.RequireDigit && !hasDigit {
+		return errors.New("password must contain a digit")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		return errors.New("password must contain a symbol")
+	}
+	return nil
+}
+
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), passwordHashCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// needsRehash -- true when the stored hash was made with a lower cost than the current one
+func needsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost < passwordHashCost
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const resetTokenLifetime = 30 * time.Minute
+
+func (s *server) RequestPasswordReset(ctx context.Context, in *pb.PasswordResetReq) (*pb.Empty, error) {
+	email, err := normalizeEmail(in.Email)
+	if err != nil {
+		return nil, err
+	}
+	// the reply is the same whether or not the email is registered, so accounts cannot be discovered
+	if err := requestPasswordReset(email); err != nil {
+		logger.WarnContext(ctx, "password reset request failed", "error", err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s *server) ResetPassword(ctx context.Context, in *pb.ResetPasswordReq) (*pb.Empty, error) {
+	playerID, err := resetPassword(in.Token, in.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, playerID), "password reset")
+	return &pb.Empty{}, nil
+}
+
+// normalizeEmail -- lower-cased bare address, empty input stays empty
+func normalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return "", nil
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", errors.New("invalid email address")
+	}
+	return strings.ToLower(address.Address), nil
+}
+
+// emailTaken -- true when another account already uses the email
+func emailTaken(collection *mongo.Collection, email string, playerID string) (bool, error) {
+	count, err := collection.CountDocuments(context.TODO(), bson.M{"email": email, "playerID": bson.M{"$ne": playerID}})
+	if err != nil {
+		return false, errors.New("[DB] cannot check email")
+	}
+	return count > 0, nil
+}
+
+// notifyEmailInUse -- an address already in use is not attached and the caller is not told,
+// so accounts cannot be discovered; its owner gets a notice instead
+func notifyEmailInUse(email string) {
+	body := "Someone tried to use this address for another Air Hockey account. Your account was not changed.\n" +
+		"If you forgot your password you can reset it from the login screen."
+	if err := mailer.Send(email, "Your Air Hockey email address", body); err != nil {
+		logger.Warn("email in use notice not sent", "error", err)
+	}
+}
+
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func requestPasswordReset(email string) error {
+	if email == "" {
+		return errors.New("email is required")
+	}
+	userCollection, err := db.GetUserCollection()
+	if err != nil {
+		return errors.New("[DB] user table err")
+	}
+	var user model.User
+	err = userCollection.FindOne(context.TODO(), bson.M{"email": email}).Decode(&user)
+	if err != nil {
+		return errors.New("no user with this email")
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return err
+	}
+	token := hex.EncodeToString(raw)
+	reset := &model.PasswordReset{
+		TokenHash: hashResetToken(token),
+		PlayerID:  user.PlayerID,
+		ExpiresAt: time.Now().Add(resetTokenLifetime),
+	}
+
+	resetCollection, err := db.GetPasswordResetCollection()
+	if err != nil {
+		return errors.New("[DB] password reset table err")
+	}
+	// a new request replaces any token still pending for the user
+	if _, err := resetCollection.DeleteMany(context.TODO(), bson.M{"playerID": user.PlayerID, "used": false}); err != nil {
+		return errors.New("[DB] cannot clear old reset tokens")
+	}
+	if _, err := resetCollection.InsertOne(context.TODO(), reset); err != nil {
+		return errors.New("[DB] cannot store reset token")
+	}
+
+	body := "Hi " + user.Name + ",\n\n" +
+		"Use this code to reset your Air Hockey password: " + token + "\n" +
+		"It expires in 30 minutes. If you did not ask for a reset you can ignore this mail."
+	return mailer.Send(email, "Reset your Air Hockey password", body)
+}
+
+// resetPassword -- consumes the token and sets the new password, returns the player ID
+func resetPassword(token string, newPassword string) (string, error) {
+	if err := passwordPolicy.Validate(newPassword); err != nil {
+		return "", err
+	}
+	resetCollection, err := db.GetPasswordResetCollection()
+	if err != nil {
+		return "", errors.New("[DB] password reset table err")
+	}
+	// marking the token used in the same operation keeps it single-use under concurrent requests
+	filter := bson.M{
+		"tokenHash": hashResetToken(token),
+		"used":      false,
+		"expiresAt": bson.M{"$gt": time.Now()},
+	}
+	var reset model.PasswordReset
+	err = resetCollection.FindOneAndUpdate(context.TODO(), filter, bson.M{"$set": bson.M{"used": true}}).Decode(&reset)
+	if err != nil {
+		return "", errors.New("invalid or expired reset token")
+	}
+
+	userCollection, err := db.GetUserCollection()
+	if err != nil {
+		return "", errors.New("[DB] user table err")
+	}
+	var user model.User
+	err = userCollection.FindOne(context.TODO(), bson.M{"playerID": reset.PlayerID}).Decode(&user)
+	if err != nil {
+		return "", errors.New("no record of user in DB")
+	}
+	if err := changePassword(&user, newPassword); err != nil {
+		return "", err
+	}
+	loginAttempts.succeed(user.UserName)
+	return user.PlayerID, nil
+}
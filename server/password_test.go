@@ -0,0 +1,35 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	strict := PasswordPolicy{MinLength: 8, MaxLength: 72, RequireLetter: true, RequireDigit: true, RequireSymbol: true}
+	loose := PasswordPolicy{MinLength: 4, MaxLength: 10}
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		wantErr  bool
+	}{
+		{"valid", strict, "hockey12!", false},
+		{"too short", strict, "hoc12!", true},
+		{"too long", strict, "hockey12!" + strings.Repeat("a", 64), true},
+		{"no letter", strict, "12345678!", true},
+		{"no digit", strict, "hockeypuck!", true},
+		{"no symbol", strict, "hockey1234", true},
+		{"length counts runes", strict, "ñññññ1!a", false},
+		{"loose policy", loose, "puck", false},
+		{"loose too short", loose, "pk", true},
+		{"max length counts bytes", loose, "ññññññ", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(tt.password); (err != nil) != tt.wantErr {
+				t.Errorf("Validate(%q) error = %v, wantErr %v", tt.password, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"air-hockey-backend/pb"
+	"errors"
+	"sync"
+)
+
+// presenceSubscriber -- one SubscribePresence stream, receives changes of its user's friends
+type presenceSubscriber struct {
+	userName string
+	friends  map[string]bool
+	updates  chan *pb.PresenceUpdate
+}
+
+var (
+	presenceMu          sync.Mutex
+	presenceSubscribers = make(map[*presenceSubscriber]bool)
+
+	// player IDs whose status may have changed, consumed by runPresence
+	presenceEvents = make(chan string, 1024)
+)
+
+func (s *server) SubscribePresence(in *pb.SessionReq, svr pb.AirHockeyService_SubscribePresenceServer) error {
+	lock.RLock()
+	player, ok := players[in.Uuid]
+	lock.RUnlock()
+	if !ok || !tokenMatches(player, in.SessionToken) {
+		return errors.New("no active session for this player")
+	}
+	social, err := getSocial(player.name)
+	if err != nil {
+		return err
+	}
+
+	sub := &presenceSubscriber{
+		userName: player.name,
+		friends:  make(map[string]bool),
+		updates:  make(chan *pb.PresenceUpdate, 100),
+	}
+	for _, friend := range social.Friends {
+		sub.friends[friend] = true
+	}
+	presenceMu.Lock()
+	presenceSubscribers[sub] = true
+	presenceMu.Unlock()
+	defer func() {
+		presenceMu.Lock()
+		delete(presenceSubscribers, sub)
+		presenceMu.Unlock()
+	}()
+
+	for _, friend := range social.Friends {
+		if err := svr.Send(&pb.PresenceUpdate{UserName: friend, Status: presenceOf(friend)}); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case update := <-sub.updates:
+			if err := svr.Send(update); err != nil {
+				return err
+			}
+		case <-player.done:
+			return nil
+		case <-svr.Context().Done():
+			return svr.Context().Err()
+		}
+	}
+}
+
+// presenceChanged -- queues a status check for the player, safe to call with or without the lock held
+func presenceChanged(playerIDs ...string) {
+	for _, playerID := range playerIDs {
+		select {
+		case presenceEvents <- playerID:
+		default:
+			go func(id string) { presenceEvents <- id }(playerID)
+		}
+	}
+}
+
+// roomPresenceChanged -- queues a status check for every member of the room
+func roomPresenceChanged(room *Room) {
+	presenceChanged(room.roomPlayers...)
+}
+
+// presenceOf -- status of a user derived from the players registry and the rooms
+func presenceOf(userName string) pb.PresenceStatus {
+	lock.RLock()
+	defer lock.RUnlock()
+	for id, player := range players {
+		if player.name == userName {
+			return playerPresence(id)
+		}
+	}
+	return pb.PresenceStatus_OFFLINE
+}
+
+// playerPresence -- the caller holds the lock
+func playerPresence(playerID string) pb.PresenceStatus {
+	if _, ok := players[playerID]; !ok {
+		return pb.PresenceStatus_OFFLINE
+	}
+	for _, singleRoom := range rooms {
+		for _, p := range singleRoom.roomPlayers {
+			if p == playerID {
+				if singleRoom.state == roomPlaying {
+					return pb.PresenceStatus_IN_MATCH
+				}
+				return pb.PresenceStatus_IN_LOBBY
+			}
+		}
+	}
+	return pb.PresenceStatus_ONLINE
+}
+
+// runPresence -- turns queued player IDs into updates for subscribed friends, only real changes are sent
+func runPresence() {
+	names := make(map[string]string) // player ID -> user name, remembered so logouts can still be reported
+	last := make(map[string]pb.PresenceStatus)
+	for playerID := range presenceEvents {
+		lock.RLock()
+		status := playerPresence(playerID)
+		if player, ok := players[playerID]; ok {
+			names[playerID] = player.name
+		}
+		lock.RUnlock()
+
+		userName, ok := names[playerID]
+		if !ok {
+			continue
+		}
+		if status == pb.PresenceStatus_OFFLINE {
+			delete(names, playerID)
+		}
+		if previous, seen := last[userName]; seen && previous == status {
+			continue
+		}
+		if status == pb.PresenceStatus_OFFLINE {
+			delete(last, userName)
+		} else {
+			last[userName] = status
+		}
+		publishPresence(&pb.PresenceUpdate{UserName: userName, Status: status})
+	}
+}
+
+func publishPresence(update *pb.PresenceUpdate) {
+	presenceMu.Lock()
+	defer presenceMu.Unlock()
+	for sub := range presenceSubscribers {
+		if sub.friends[update.UserName] {
+			select {
+			case sub.updates <- update:
+			default:
+				logger.Warn("presence update dropped, subscriber too slow", "userName", sub.userName)
+			}
+		}
+	}
+}
+
+// presenceFriendsChanged -- keeps open subscriptions in line with the friend list, a new friend's status is sent at once
+func presenceFriendsChanged(userName string, friend string, added bool) {
+	presenceMu.Lock()
+	defer presenceMu.Unlock()
+	for sub := range presenceSubscribers {
+		var other string
+		switch sub.userName {
+		case userName:
+			other = friend
+		case friend:
+			other = userName
+		default:
+			continue
+		}
+		if !added {
+			delete(sub.friends, other)
+			continue
+		}
+		sub.friends[other] = true
+		select {
+		case sub.updates <- &pb.PresenceUpdate{UserName: other, Status: presenceOf(other)}:
+		default:
+		}
+	}
+}
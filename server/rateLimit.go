@@ -0,0 +1,210 @@
+package main
+
+import (
+	"air-hockey-backend/pb"
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type limit struct {
+	rate  rate.Limit
+	burst int
+}
+
+var (
+	defaultIPLimit = limit{rate: 20, burst: 40}
+	playerLimit    = limit{rate: 10, burst: 20}
+	streamLimit    = limit{rate: 120, burst: 240} // inbound GameStream messages, a few times the client tick rate
+
+	// tighter per-IP limits for RPCs that can be used to brute-force passwords or spam rooms
+	methodIPLimits = map[string]limit{
+		"/AirHockey.AirHockeyService/NewAccount": {rate: 0.2, burst: 3},
+		"/AirHockey.AirHockeyService/Login":      {rate: 1, burst: 5},
+		"/AirHockey.AirHockeyService/NewRoom":    {rate: 0.5, burst: 3},
+
+		"/AirHockey.AirHockeyService/LoginAsGuest":      {rate: 0.2, burst: 3},
+		"/AirHockey.AirHockeyService/ClaimGuestAccount": {rate: 0.2, burst: 3},
+
+		"/AirHockey.AirHockeyService/RequestPasswordReset": {rate: 0.1, burst: 2},
+		"/AirHockey.AirHockeyService/ResetPassword":        {rate: 0.5, burst: 5},
+
+		"/AirHockey.AirHockeyService/ReportPlayer": {rate: 0.1, burst: 3},
+		"/AirHockey.AirHockeyService/SendGift":     {rate: 0.2, burst: 3},
+	}
+
+	loginMaxFailures   = 5
+	loginFailureWindow = 10 * time.Minute
+	loginLockout       = 15 * time.Minute
+
+	limiterIdleTime = 10 * time.Minute
+)
+
+var errRateLimited = status.Error(codes.ResourceExhausted, "rate limit exceeded, slow down")
+
+// limiterStore -- token buckets keyed by IP or player, idle buckets are evicted
+type limiterStore struct {
+	mu       sync.Mutex
+	limiters map[string]*limiterEntry
+}
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+var limiters = &limiterStore{limiters: make(map[string]*limiterEntry)}
+
+func (s *limiterStore) allow(key string, l limit) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry, ok := s.limiters[key]
+	if !ok {
+		entry = &limiterEntry{limiter: rate.NewLimiter(l.rate, l.burst)}
+		s.limiters[key] = entry
+	}
+	entry.lastSeen = time.Now()
+	return entry.limiter.Allow()
+}
+
+func (s *limiterStore) evictIdle() {
+	for {
+		time.Sleep(limiterIdleTime)
+		s.mu.Lock()
+		for key, entry := range s.limiters {
+			if time.Since(entry.lastSeen) > limiterIdleTime {
+				delete(s.limiters, key)
+			}
+		}
+		s.mu.Unlock()
+		loginAttempts.evictExpired()
+	}
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// sessionRequest -- requests that act for a player with its session token
+type sessionRequest interface {
+	GetUuid() string
+	GetSessionToken() string
+}
+
+// requestPlayerID -- the player a request acts for, empty unless the request carries that player's session token,
+// so nobody can drain another player's bucket with a player ID alone
+func requestPlayerID(req interface{}) string {
+	var playerID, token string
+	switch r := req.(type) {
+	case *pb.RankAndCash:
+		playerID, token = r.GetPlayerID(), r.GetSessionToken()
+	case *pb.JoinRequest:
+		playerID, token = r.GetPlayerInfo().GetUuid(), r.GetSessionToken()
+	case *pb.LeaveRequest:
+		playerID, token = r.GetPlayerInfo().GetUuid(), r.GetSessionToken()
+	case *pb.NewGameInfo:
+		playerID, token = r.GetHost(), r.GetSessionToken()
+	case sessionRequest:
+		playerID, token = r.GetUuid(), r.GetSessionToken()
+	}
+	if playerID == "" || !validSession(playerID, token) {
+		return ""
+	}
+	return playerID
+}
+
+func unaryRateLimitInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ip := peerIP(ctx)
+	ipLimit, ok := methodIPLimits[info.FullMethod]
+	if !ok {
+		ipLimit = defaultIPLimit
+	}
+	if !limiters.allow("ip:"+ip+":"+info.FullMethod, ipLimit) {
+		rateLimited.WithLabelValues("ip").Inc()
+		logger.WarnContext(ctx, "rate limited", "ip", ip)
+		return nil, errRateLimited
+	}
+	if playerID := requestPlayerID(req); playerID != "" && !limiters.allow("player:"+playerID, playerLimit) {
+		rateLimited.WithLabelValues("player").Inc()
+		logger.WarnContext(withPlayer(ctx, playerID), "rate limited")
+		return nil, errRateLimited
+	}
+	return handler(ctx, req)
+}
+
+func streamRateLimitInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !limiters.allow("ip:"+peerIP(ss.Context())+":"+info.FullMethod, defaultIPLimit) {
+		rateLimited.WithLabelValues("ip").Inc()
+		return errRateLimited
+	}
+	return handler(srv, ss)
+}
+
+// loginGuard -- counts failed logins per user name and locks the account out for a while after too many
+type loginGuard struct {
+	mu       sync.Mutex
+	attempts map[string]*loginAttempt
+}
+
+type loginAttempt struct {
+	failures     int
+	firstFailure time.Time
+	lockedUntil  time.Time
+}
+
+var loginAttempts = &loginGuard{attempts: make(map[string]*loginAttempt)}
+
+func (g *loginGuard) locked(userName string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	attempt, ok := g.attempts[userName]
+	return ok && time.Now().Before(attempt.lockedUntil)
+}
+
+func (g *loginGuard) fail(userName string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := time.Now()
+	attempt, ok := g.attempts[userName]
+	if !ok || now.Sub(attempt.firstFailure) > loginFailureWindow {
+		attempt = &loginAttempt{firstFailure: now}
+		g.attempts[userName] = attempt
+	}
+	attempt.failures++
+	if attempt.failures >= loginMaxFailures {
+		attempt.lockedUntil = now.Add(loginLockout)
+		logger.Warn("login locked out after repeated failures", "userName", userName, "until", attempt.lockedUntil)
+	}
+}
+
+func (g *loginGuard) succeed(userName string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.attempts, userName)
+}
+
+func (g *loginGuard) evictExpired() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := time.Now()
+	for userName, attempt := range g.attempts {
+		if now.After(attempt.lockedUntil) && now.Sub(attempt.firstFailure) > loginFailureWindow {
+			delete(g.attempts, userName)
+		}
+	}
+}
@@ -3,21 +3,38 @@ package main
 import (
 	"air-hockey-backend/model"
 	"air-hockey-backend/pb"
+	"air-hockey-backend/config/db"
 	"context"
 	"errors"
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
-	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
 	"sync"
+	"syscall"
+	"time"
 )
+
+// port for the HTTP endpoints: /metrics, /loglevel, /healthz and /readyz
+const httpPort = ":9090"
 //important global variable
 var rankList [10]model.PlayerRank
 var lock = &sync.RWMutex{}
 var players = make(map[string]*Player)
 var rooms = make(map[string]*Room)
 
+// room states, a room is waiting until its host broadcasts a GameState with isPlaying set
+const (
+	roomWaiting = "waiting"
+	roomPlaying = "playing"
+)
+
 type server struct{}
 
 type Room struct {
@@ -26,13 +43,28 @@ type Room struct {
 	roomPlayers 	[]string
 	maxPlayer		int32
 	maxScore		int32
+	private			bool
+	state			string
+	stake			int32					// cash each player puts in the pot, 0 for no wager
+	wager			*wager					// stakes held while the room is playing
+	match			*roomMatch				// teams and score of the current or last match
 	WaitGroup 		*sync.WaitGroup
 }
 
 type Player struct {
 	name      		string
-	channel 		chan pb.GameMessage
+	channel 		chan *pb.GameMessage
 	uuid 			string
+	token			string					// session token handed out at login
+	done			chan struct{}			// closed when the session ends, stops its GameStream
+	muted			map[string]bool			// user names whose chat is not delivered to this player
+	emotes			map[int32]bool			// unlocked emotes
+	lastEmote		time.Time
+	chatMutedUntil	time.Time				// set by moderators, chat is dropped until then
+	loadout			model.Loadout			// equipped skins, sent to the room when it starts playing
+	createdAt		time.Time
+	streaming		bool					// a GameStream is attached, set under the lock
+	closeOnce		sync.Once
 	WaitGroup 		*sync.WaitGroup
 }
 
@@ -41,49 +73,140 @@ func main() {
 	lis, err := net.Listen("tcp", port)
 
 	if err != nil {
-		log.Fatalf("Failed to listen %v", err)
+		logger.Error("failed to listen", "port", port, "error", err)
+		os.Exit(1)
 	}
-	log.Println("Server opened port 8080")
+	logger.Info("server opened port", "port", port)
+
+	// Collect MongoDB latency and expose metrics over HTTP.
+	db.Monitor = mongoMonitor()
+	if err := db.Connect(context.Background()); err != nil {
+		logger.Error("failed to create database client", "error", err)
+		os.Exit(1)
+	}
+	go serveHTTP(httpPort)
+
 	// Initializes the gRPC server.
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryMetricsInterceptor, unaryLoggingInterceptor, unaryRateLimitInterceptor),
+		grpc.ChainStreamInterceptor(streamMetricsInterceptor, streamLoggingInterceptor, streamRateLimitInterceptor),
+	)
+	go limiters.evictIdle()
+	go runPresence()
+	go evictExpiredInvitations()
+	go ensureIndexes()
+	go seedSkinCatalog(skinCatalogFile())
+	go migrateSkins()
+	go expireGifts()
+	go expireGuests()
+	go seedShop(shopFile())
 
 	// Register the server with gRPC.
 	pb.RegisterAirHockeyServiceServer(s, &server{})
 
+	// Register health checking, NOT_SERVING until the database answers.
+	setServing(false)
+	healthpb.RegisterHealthServer(s, healthServer)
+	go watchDatabase()
+
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
-	log.Println("Server running")
+	logger.Info("server running")
+
+	go shutdownOnSignal(s)
 
 	if err := s.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+		logger.Error("failed to serve", "error", err)
+		os.Exit(1)
+	}
+	if err := db.Disconnect(context.Background()); err != nil {
+		logger.Warn("database client not closed", "error", err)
+	}
+}
+
+func serveHTTP(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/loglevel", handleLogLevel)
+	mux.HandleFunc("/healthz", handleHealthz)
+	mux.HandleFunc("/readyz", handleReadyz)
+	logger.Info("http endpoints available", "addr", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		logger.Error("http server stopped", "error", err)
+	}
+}
+
+// shutdownOnSignal -- on SIGINT/SIGTERM report NOT_SERVING, let the load balancer drain, then stop
+func shutdownOnSignal(s *grpc.Server) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	sig := <-signals
+	logger.Info("shutting down, draining", "signal", sig.String(), "drainPeriod", drainPeriod)
+	healthServer.Shutdown()
+	time.Sleep(drainPeriod)
+
+	// game streams never end on their own, force them closed if they outlive a second drain period
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(drainPeriod):
+		s.Stop()
 	}
 }
 
 func (s *server) NewAccount(_ context.Context, accountReq *pb.NewAccountReq) (*pb.Empty, error){
 	name := accountReq.Name
 	info := accountReq.AccountInfo
-	errorAccount := RegisterHandler(name, info.UserName, info.Password)
+	errorAccount := RegisterHandler(name, info.UserName, info.Password, accountReq.Email)
 	if errorAccount != nil{
 		return nil, errorAccount
 	}
 	return &pb.Empty{}, nil
 }
 
-func (s *server) Login(_ context.Context, account *pb.Account) (*pb.LoginPlayerInfo, error) {
+func (s *server) Login(ctx context.Context, account *pb.Account) (*pb.LoginPlayerInfo, error) {
 
 	result, errLogin :=LoginHandler(account.UserName, account.Password)
 	if errLogin != nil{
 		return nil, errLogin
 	}
-	AddPlayer(result.PlayerID,account.UserName)
-	log.Print("[AddedPlayer], ", result.Name, result.PlayerID, result.Cash, result.Rank )
-	return &pb.LoginPlayerInfo{Name: result.Name, Uuid: result.PlayerID, Cash: int32(result.Cash), Rank: int32(result.Rank)}, nil
+	if errBan := checkBan(result); errBan != nil{
+		return nil, errBan
+	}
+	player, errSession := AddPlayer(result.PlayerID,account.UserName)
+	if errSession != nil{
+		return nil, errSession
+	}
+	lock.Lock()
+	player.chatMutedUntil = result.ChatMutedUntil
+	lock.Unlock()
+	loadMuted(player)
+	loadEmotes(player)
+	loadLoadout(player)
+	loginInfo := &pb.LoginPlayerInfo{Name: result.Name, Uuid: result.PlayerID, Cash: int32(result.Cash), Rank: int32(result.Rank), SessionToken: player.token}
+	reward, errReward := claimDailyReward(result.PlayerID, time.Now())		// a failed claim does not block the login, the client can retry with ClaimDailyReward
+	if errReward != nil{
+		logger.WarnContext(withPlayer(ctx, result.PlayerID), "daily reward not claimed at login", "error", errReward)
+	} else {
+		loginInfo.DailyReward = reward
+		loginInfo.Cash = reward.Cash
+	}
+	logger.InfoContext(withPlayer(ctx, result.PlayerID), "player logged in", "name", result.Name, "cash", loginInfo.Cash, "rank", result.Rank, "dailyReward", loginInfo.GetDailyReward().GetReward())
+	return loginInfo, nil
 }
 
 func (s *server) NewRecord(_ context.Context, inRecord *pb.Record) (*pb.RecordID, error) {
-
-	uuid, errNewRec := storeRecord(inRecord)
+	match, errMatch := endedMatch(inRecord.Uuid, inRecord.SessionToken, inRecord.RoomID)		// teams and score come from the server, once per match
+	if errMatch != nil{
+		return nil, errMatch
+	}
+	uuid, errNewRec := storeRecord(inRecord.GetRecordTime(), match)
 	if errNewRec != nil{
+		reopenMatch(match)
 		return nil, errNewRec
 	}
 	// insert record
@@ -103,31 +226,64 @@ func (s *server) GetGlobalRecord(context.Context, *pb.Empty) (*pb.RankingList, e
 }
 
 func (s *server) UpdateRankAndCash(_ context.Context, rankAndCast *pb.RankAndCash) (*pb.Empty, error){
+	if !validSession(rankAndCast.PlayerID, rankAndCast.SessionToken){
+		return nil, errors.New("no active session for this player")
+	}
 	err := changeRankAndCash(rankAndCast)
-	if err == nil{
+	if err != nil{
 		return nil, err
 	}
 	return &pb.Empty{}, nil
 }
 
 func (s *server) AddSkin(_ context.Context, addSkinReq *pb.AddNewSkin) (*pb.SkinList, error){
-	err := playerUpdateSkin(addSkinReq)
+	if addSkinReq.SkinType == itemEmote {
+		if _, ok := emotes[addSkinReq.SkinID]; !ok {
+			return nil, errors.New("unknown emote")
+		}
+	}
+	// only free skins of the catalog can be added, the others go through PurchaseSkin
+	skin, err := catalogSkin(addSkinReq.SkinType, addSkinReq.SkinID)
+	if err != nil{
+		return nil, err
+	}
+	if skin.Price > 0 {
+		return nil, errors.New("this skin must be purchased")
+	}
+	if !skinAvailable(skin, time.Now()) {
+		return nil, errors.New("this skin is not available")
+	}
+	added, err := grantItem(addSkinReq.PlayerID, addSkinReq.SkinType, addSkinReq.SkinID, 1)
 	if err != nil{
 		return nil, err
 	}
+	if !added {
+		return nil, errors.New("skin already owned")
+	}
 
-	return nil, nil
+	return GetSkinByID(addSkinReq.PlayerID)
 }
 
 func (s *server) GetSkinList(_ context.Context, playerID *pb.PlayerID) (*pb.SkinList, error){
-	playerSkins, err := GetSkinByID(int(playerID.GetID()))
+	playerSkins, err := GetSkinByID(strconv.Itoa(int(playerID.GetID())))
 	if err != nil {
 		return nil, err
 	}
 	return playerSkins, nil
 }
 
-func (s *server) NewRoom(_ context.Context, in *pb.NewGameInfo) (*pb.RoomID, error) {
+func (s *server) NewRoom(ctx context.Context, in *pb.NewGameInfo) (*pb.RoomID, error) {
+	if !validSession(in.Host, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	if in.Stake < 0 {
+		return nil, errors.New("stake cannot be negative")
+	}
+	if in.Stake > 0 {
+		if err := refuseGuestStake(in.Host); err != nil {
+			return nil, err
+		}
+	}
 	lock.Lock()													// each room will be initialized with a locker, max score of game, then add to the global list of all room
 	defer lock.Unlock()
 
@@ -136,45 +292,78 @@ func (s *server) NewRoom(_ context.Context, in *pb.NewGameInfo) (*pb.RoomID, err
 		ID:      		uuid.New(),
 		maxPlayer: 		in.NumberOfPlayer,
 		maxScore: 		in.TargetScore,
+		private: 		in.IsPrivate,
+		state: 			roomWaiting,
+		stake: 			in.Stake,
 		WaitGroup: 		&sync.WaitGroup{},
 	}
 	newRoomID := newRoom.ID.String()
-	log.Print("[AddRoom]: with ID " + newRoom.ID.String())
+	logger.InfoContext(withRoom(withPlayer(ctx, in.Host), newRoomID), "room created", "maxPlayer", in.NumberOfPlayer, "maxScore", in.TargetScore, "stake", in.Stake)
 	rooms[newRoomID] = newRoom
 	rooms[newRoomID].roomPlayers = append(rooms[newRoomID].roomPlayers, in.Host)
 	rooms[newRoomID].WaitGroup.Add(1)
-	return &pb.RoomID{UniqueID: newRoomID}, nil
+	presenceChanged(in.Host)
+	return &pb.RoomID{UniqueID: newRoomID, Stake: in.Stake}, nil
 }
 
 func (s *server) JoinRoom(_ context.Context, in *pb.JoinRequest) (*pb.RoomID, error){
+	if !validSession(in.GetPlayerInfo().GetUuid(), in.SessionToken){
+		return nil, errors.New("no active session for this player")
+	}
 	roomID := in.RoomID																				// take the roomID and join, if empty, then server will find it
-	if roomID == ""{																				// case empty: find an empty room in the room list
+	if roomID == ""{																				// case empty: find a public room which is not full
+		lock.Lock()
+		defer lock.Unlock()
 		for _, singleRoom := range rooms{
-			if len(singleRoom.roomPlayers) != int(singleRoom.maxPlayer) {
+			if !singleRoom.private && singleRoom.stake == 0 && len(singleRoom.roomPlayers) < int(singleRoom.maxPlayer) {
 				singleRoom.roomPlayers = append(singleRoom.roomPlayers, in.PlayerInfo.Uuid)
 				roomID = singleRoom.ID.String()
+				presenceChanged(in.PlayerInfo.Uuid)
+				break
 			}
-			break
 		}
 		return &pb.RoomID{UniqueID: roomID}, nil
 	}
 
-	if RoomExists(in.RoomID ) {																		// case found
-		AddPlayerToRoom(in.PlayerInfo.Uuid, in.RoomID)
-		return &pb.RoomID{UniqueID: in.RoomID}, nil
+	if private, stake, ok := roomSettings(in.RoomID); ok {												// case found
+		if stake > 0 {
+			if err := refuseGuestStake(in.PlayerInfo.Uuid); err != nil {
+				return nil, err
+			}
+		}
+		if private {
+			blocked, errBlocked := blockedInRoom(in.PlayerInfo.Uuid, in.RoomID)
+			if errBlocked != nil {
+				return nil, errBlocked
+			}
+			if blocked {
+				return nil, errors.New("cannot join this room")
+			}
+		}
+		if err := AddPlayerToRoom(in.PlayerInfo.Uuid, in.RoomID); err != nil {
+			return nil, err
+		}
+		return &pb.RoomID{UniqueID: in.RoomID, Stake: stake}, nil
 	}
 
 	return nil, errors.New("room does not exist")												// case all room are full
 }
 
-func (s *server) GetPlayerList(_ context.Context, roomID *pb.RoomID) (*pb.PlayerList, error) {
-	listID := rooms[roomID.UniqueID].roomPlayers
+func (s *server) GetPlayerList(ctx context.Context, roomID *pb.RoomID) (*pb.PlayerList, error) {
+	lock.RLock()
+	room, ok := rooms[roomID.UniqueID]
+	if !ok {
+		lock.RUnlock()
+		return nil, errors.New("room does not exist")
+	}
 	var c []string
-	for _, singleID := range listID{
-		c = append(c, players[singleID].name)
+	for _, singleID := range room.roomPlayers{
+		if player, ok := players[singleID]; ok {
+			c = append(c, player.name)
+		}
 	}
-	log.Print("[GetPlayerList]: Returned list of current Clients ")
-	log.Print(c)
+	lock.RUnlock()
+	logger.DebugContext(withRoom(ctx, roomID.UniqueID), "returned list of current clients", "players", c)
 	return &pb.PlayerList{Players: c}, nil
 }
 
@@ -183,46 +372,70 @@ func (s *server) GameStream(svr pb.AirHockeyService_GameStreamServer) error {
 	if err != nil {
 		return err
 	}
-	outbox := make(chan pb.GameMessage, 100)
+	player, err := attachStream(req.Sender, req.SessionToken)		// the session this stream belongs to, a later login replaces it
+	if err != nil {
+		return err
+	}
+	defer detachStream(player)
+	outbox := make(chan *pb.GameMessage, 100)
 	go ListenToClient(svr, outbox)
 
 	for {
 		select {
-		case outMsg := <-outbox:												// 1. HERE message from outbox
+		case outMsg, ok := <-outbox:											// 1. HERE message from outbox
+			if !ok {														// the client closed the stream
+				return nil
+			}
+			outMsg.Sender = player.uuid										// never trust the sender the client wrote
+			streamMessages.WithLabelValues(actionName(outMsg)).Inc()
 			switch outMsg.GetAction().(type) {
 			case *pb.GameMessage_GameState :										// 1a. broadcast game state for: start game, end game, left room
-				BroadcastGameState(outMsg.GetGameState().RoomID, outMsg)
-				//log.Print("[GAME_STATE]")
+				if acceptGameState(outMsg) && wagerTransition(outMsg) {
+					BroadcastGameState(outMsg.GetGameState().RoomID, outMsg)
+				}
 			case *pb.GameMessage_EntityState:										// 1b. broadcast entity position to player who is not host player
 				BroadcastToPlayer(outMsg.GetEntityState().RoomID, outMsg)
-				//log.Print("[ENTITY] message", outMsg.GetEntityState().RoomID)
 			case *pb.GameMessage_PlayerInput:										// 1c. broadcast game input from player to host
 				hostID := GetHost(outMsg)
 				BroadcastToSpecificClient(hostID, outMsg)
 			case *pb.GameMessage_Empty:												// 1d. client interruption
-				//log.Println("[Init] Interruption from client")
 				BroadcastToSpecificClient(outMsg.Sender, outMsg)
+			case *pb.GameMessage_ChatMessage:										// 1e. chat to the room or the lobby
+				BroadcastChat(outMsg)
+			case *pb.GameMessage_Emote:												// 1f. quick-chat emote to the room
+				BroadcastEmote(outMsg)
 			case nil:
-				//log.Print("[NIL_ACTION] end of client")
 			}
-		case inMsg := <-players[req.Sender].channel:							// 2. SEND message to suitable channel
-			//log.Print("[CHANEL]",players[req.Sender].channel)
-			err := svr.Send(&inMsg)
+		case inMsg := <-player.channel:										// 2. SEND message to suitable channel
+			err := svr.Send(inMsg)
 			if err != nil {
 				return err
 			}
+		case <-player.done:													// 3. session ended, flush what is left (e.g. the kick notice)
+			for {
+				select {
+				case inMsg := <-player.channel:
+					if err := svr.Send(inMsg); err != nil {
+						return err
+					}
+				default:
+					return nil
+				}
+			}
+		case <-svr.Context().Done():
+			return svr.Context().Err()
 		}
 	}
 }
 
-func (s *server) Disconnect(_ context.Context, playerInfo *pb.LeaveRequest) (*pb.Empty, error){
+func (s *server) Disconnect(ctx context.Context, playerInfo *pb.LeaveRequest) (*pb.Empty, error){
 
-	playerName := playerInfo.PlayerInfo.Uuid
+	playerName := playerInfo.GetPlayerInfo().GetUuid()
 	roomID := playerInfo.RoomID
 
-	log.Print("[Disconnect]: Disconnecting player " + playerName)
+	logger.InfoContext(withRoom(withPlayer(ctx, playerName), roomID), "disconnecting player")
 
-	err := RemovePlayer(playerName, roomID)
+	err := RemovePlayer(playerName, playerInfo.SessionToken, roomID)
 
 	if err != nil {
 		return nil, err
@@ -231,21 +444,26 @@ func (s *server) Disconnect(_ context.Context, playerInfo *pb.LeaveRequest) (*pb
 	return &pb.Empty{}, nil
 }
 
-func (s *server) LeaveRoom(_ context.Context,leaveRequest *pb.LeaveRequest) (*pb.Empty, error){
-	log.Printf("[LeaveRoom]start leave room")
-
-	playerID := leaveRequest.PlayerInfo.Uuid
+func (s *server) LeaveRoom(ctx context.Context,leaveRequest *pb.LeaveRequest) (*pb.Empty, error){
+	playerID := leaveRequest.GetPlayerInfo().GetUuid()
 	roomID := leaveRequest.RoomID
+	ctx = withRoom(withPlayer(ctx, playerID), roomID)
+	logger.DebugContext(ctx, "start leave room")
+	if !validSession(playerID, leaveRequest.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
 
 	if !RoomExists(roomID) {
-		log.Printf("[LeaveRoom]no room exist with ID")
+		logger.WarnContext(ctx, "no room exists with ID")
 		return &pb.Empty{}, errors.New("the room ID " + roomID + " doesn't exist")
 	} else if !ClientExists(playerID) {
-		log.Printf("[LeaveRoom]no client exist with ID")
+		logger.WarnContext(ctx, "no client exists with ID")
 		return &pb.Empty{}, errors.New("the player ID " + playerID + " doesn't exists")
 	}
 
+	lock.Lock()
 	err := RemovePlayerFromRoom(playerID, roomID)
+	lock.Unlock()
 	if err != nil {
 		return nil, err
 	}
@@ -3,22 +3,41 @@ package main
 import (
 	"air-hockey-backend/pb"
 	"errors"
+	"golang.org/x/time/rate"
 	"io"
-	"log"
 	"sync"
+	"time"
 )
 
-func AddPlayer(id string, name string) {
+// AddPlayer -- starts a session for the player, an existing session is kicked or the login rejected depending on sessionPolicy
+func AddPlayer(id string, name string) (*Player, error) {
+	token, err := newSessionToken()
+	if err != nil {
+		return nil, err
+	}
 	lock.Lock()
 	defer lock.Unlock()
+	if oldPlayer, ok := players[id]; ok {
+		if sessionPolicy == sessionRejectNew && sessionAlive(oldPlayer) {
+			return nil, errors.New("this account is already logged in")
+		}
+		kickSession(oldPlayer, "logged in from another device")
+	}
 	newPlayer := &Player{
 		name:      			name,
-		channel: 		 	make(chan pb.GameMessage, 100),
+		channel: 		 	make(chan *pb.GameMessage, 100),
 		WaitGroup:			&sync.WaitGroup{},
 		uuid: 				id,
+		token: 				token,
+		done: 				make(chan struct{}),
+		muted: 				make(map[string]bool),
+		emotes: 			make(map[int32]bool),
+		createdAt: 			time.Now(),
 	}
-	log.Print("[AddClient]: Registered player: " + name)
+	logger.Info("registered player", "player", id, "userName", name)
 	players[id] = newPlayer
+	presenceChanged(id)
+	return newPlayer, nil
 }
 
 func RoomExists(roomID string) bool {
@@ -32,13 +51,35 @@ func RoomExists(roomID string) bool {
 	return false
 }
 
-func AddPlayerToRoom(playerID string, roomID string)  {
-	rooms[roomID].WaitGroup.Add(1)
-	defer rooms[roomID].WaitGroup.Done()
-	rooms[roomID].roomPlayers = append(rooms[roomID].roomPlayers, playerID)
+// roomSettings -- privacy and stake of a room, ok is false when it does not exist
+func roomSettings(roomID string) (private bool, stake int32, ok bool) {
+	lock.RLock()
+	defer lock.RUnlock()
+	room, ok := rooms[roomID]
+	if !ok {
+		return false, 0, false
+	}
+	return room.private, room.stake, true
 }
 
-func GetHost(playerInput pb.GameMessage) string{
+// AddPlayerToRoom -- takes the lock, the room may have closed since it was looked up
+func AddPlayerToRoom(playerID string, roomID string) error {
+	lock.Lock()
+	defer lock.Unlock()
+	room, ok := rooms[roomID]
+	if !ok {
+		return errors.New("room does not exist")
+	}
+	room.WaitGroup.Add(1)
+	defer room.WaitGroup.Done()
+	room.roomPlayers = append(room.roomPlayers, playerID)
+	presenceChanged(playerID)
+	return nil
+}
+
+func GetHost(playerInput *pb.GameMessage) string{
+	lock.RLock()
+	defer lock.RUnlock()
 	roomID := playerInput.GetPlayerInput().RoomID
 	for _, singleRoom := range rooms{
 		if singleRoom.ID.String() == roomID{
@@ -48,54 +89,80 @@ func GetHost(playerInput pb.GameMessage) string{
 	return "Wrong room ID"
 }
 
-func BroadcastToSpecificClient(clientID string, msg pb.GameMessage){
+func BroadcastToSpecificClient(clientID string, msg *pb.GameMessage){
 	lock.Lock()
 	defer lock.Unlock()
-	players[clientID].channel <- msg
+	deliver(players[clientID], msg)
+}
+
+// deliver -- queue the message without blocking, a full channel means the client is too slow and the frame is dropped
+func deliver(player *Player, msg *pb.GameMessage) {
+	if player == nil {
+		return
+	}
+	select {
+	case player.channel <- msg:
+	default:
+		droppedFrames.WithLabelValues(actionName(msg)).Inc()
+	}
 }
 
-func ListenToClient(svr pb.AirHockeyService_GameStreamServer, messages chan<- pb.GameMessage) {
+// ListenToClient -- forwards what the client sends, messages is closed when the stream ends
+func ListenToClient(svr pb.AirHockeyService_GameStreamServer, messages chan<- *pb.GameMessage) {
+	defer close(messages)
+	inbound := rate.NewLimiter(streamLimit.rate, streamLimit.burst)			// per-stream cap, extra messages are dropped
 	for {
 		req, err := svr.Recv()
 		if err == io.EOF {
-			log.Printf("[ListenToClient] A player had been disconnected")
+			logger.InfoContext(svr.Context(), "player stream closed by client")
 			return
 		}
 		if err != nil{
-			log.Print(err)
+			logger.WarnContext(svr.Context(), "player stream receive failed", "error", err)
 			return
+		}else if !inbound.Allow() {
+			rateLimited.WithLabelValues("stream").Inc()
+			droppedFrames.WithLabelValues(actionName(req)).Inc()
 		}else {
-			messages <- *req
+			messages <- req
 		}
 	}
 }
 
-func BroadcastToPlayer(roomID string, msg pb.GameMessage) {
+func BroadcastToPlayer(roomID string, msg *pb.GameMessage) {
 	lock.Lock()
 	defer lock.Unlock()
 	for singleRoom := range rooms {
 		if singleRoom == roomID {
-			log.Printf("[Broadcast] Client " + msg.Sender)
 			for _, c := range rooms[roomID].roomPlayers {
-				log.Printf("[Broadcast] Found " + c + " in room")
-				log.Printf("[Broadcast] Adding the message to " + c + "'s channel.")
 				if c !=  msg.Sender{
-					players[c].channel <- msg
+					deliver(players[c], msg)
 				}
 			}
 		}
 	}
 }
 
-func BroadcastGameState(roomID string , msg pb.GameMessage) {
+func BroadcastGameState(roomID string , msg *pb.GameMessage) {
 	lock.Lock()
 	defer lock.Unlock()
 	for singleRoom := range rooms {
 		if singleRoom == roomID {
+			previousState := rooms[roomID].state
+			if msg.GetGameState().IsPlaying == 1 {
+				rooms[roomID].state = roomPlaying
+			} else {
+				rooms[roomID].state = roomWaiting
+			}
+			if rooms[roomID].state != previousState {
+				roomPresenceChanged(rooms[roomID])
+			}
+			if rooms[roomID].state == roomPlaying && previousState != roomPlaying {
+				msg = withLoadouts(rooms[roomID], msg)
+			}
+			logger.Debug("broadcasting game state", "room", roomID, "player", msg.Sender, "state", rooms[roomID].state)
 			for _, c := range rooms[roomID].roomPlayers {
-				log.Printf("[Broadcast] Found " + c + " in room")
-				log.Printf("[Broadcast] Adding the GameState to " + c + "'s channel.")
-				players[c].channel <- msg
+				deliver(players[c], msg)
 			}
 		}
 	}
@@ -114,6 +181,7 @@ func ClientExists(userID string) bool {
 	return false
 }
 
+// RemovePlayerFromRoom -- the caller holds the lock
 func RemovePlayerFromRoom(playerID string, roomID string) error {
 
 	for _, singleRoom := range rooms {
@@ -123,8 +191,13 @@ func RemovePlayerFromRoom(playerID string, roomID string) error {
 					singleRoom.roomPlayers[i] = singleRoom.roomPlayers[len(singleRoom.roomPlayers) - 1]
 					singleRoom.roomPlayers = singleRoom.roomPlayers[:len(singleRoom.roomPlayers) - 1]
 					if len(singleRoom.roomPlayers) == 0 {
+						if singleRoom.wager != nil && singleRoom.wager.escrowed {	// closed mid-match, nobody won the pot
+							go refundWager(roomID, singleRoom.wager)
+						}
+						singleRoom.wager = nil
 						delete(rooms, roomID)
 					}
+					presenceChanged(playerID)
 					return nil
 				}
 			}
@@ -135,24 +208,29 @@ func RemovePlayerFromRoom(playerID string, roomID string) error {
 	return errors.New("no user found in the group list. Something went wrong")
 }
 
-func RemovePlayer(playerName string, roomID string) error{
-
+// RemovePlayer -- ends the session the token belongs to and takes the player out of the room
+func RemovePlayer(playerName string, token string, roomID string) error{
+	lock.Lock()
+	defer lock.Unlock()
 
-	if ClientExists(playerName) {
+	if player, ok := players[playerName]; ok && tokenMatches(player, token) {		// ClientExists would deadlock on the held lock
+		player.close()
 		delete(players, playerName)
-		log.Print("[RemovePlayer]: Removed Player " + playerName)
+		presenceChanged(playerName)
+		logger.Info("removed player", "player", playerName)
 		if InRoom(playerName) {
 			err := RemovePlayerFromRoom(playerName, roomID)
 			if err != nil {
 				return errors.New("err while remove player from room")
 			}
+			return nil
 		} else {
-			log.Print("[RemovePlayer]: " + playerName + " was not in any room.")
+			logger.Debug("removed player was not in any room", "player", playerName)
 			return nil
 		}
 	}
 
-	return errors.New("[RemovePlayer]: Client (" + playerName + ") doesn't exist")
+	return errors.New("no active session for this player")
 }
 
 func RemoveInterruptPlayer(playerID string) error{
@@ -160,9 +238,11 @@ func RemoveInterruptPlayer(playerID string) error{
 	lock.Lock()
 	defer lock.Unlock()
 
-	if ClientExists(playerID) {
+	if player, ok := players[playerID]; ok {							// ClientExists would deadlock on the held lock
+		player.close()
 		delete(players, playerID)
-		log.Print("[RemovePlayer]: Removed Player " + playerID)
+		presenceChanged(playerID)
+		logger.Info("removed interrupted player", "player", playerID)
 		err := RemovePlayerFromContext(playerID)
 		if err != nil {
 			return errors.New("err while remove player from room")
@@ -173,6 +253,7 @@ func RemoveInterruptPlayer(playerID string) error{
 	return errors.New("[RemovePlayer]: Client (" + playerID + ") doesn't exist")
 }
 
+// InRoom -- the caller holds the lock
 func InRoom(playerName string) bool {
 
 	for _, singleRoom := range rooms {
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"air-hockey-backend/pb"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"os"
+	"time"
+)
+
+// what happens when an account that is already online logs in again
+const (
+	sessionKickOld   = "kick"   // the old session is notified and closed, the new login wins
+	sessionRejectNew = "reject" // the new login fails until the old session logs out
+)
+
+// sessionPolicy -- SESSION_POLICY=reject switches from the default kick policy
+var sessionPolicy = sessionKickOld
+
+// sessionStreamGrace -- time a new session has to open its GameStream before a reject policy stops protecting it
+const sessionStreamGrace = time.Minute
+
+func init() {
+	if os.Getenv("SESSION_POLICY") == sessionRejectNew {
+		sessionPolicy = sessionRejectNew
+	}
+}
+
+func (s *server) Logout(ctx context.Context, in *pb.LogoutRequest) (*pb.Empty, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	player, ok := players[in.Uuid]
+	if !ok || !tokenMatches(player, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	player.close()
+	delete(players, in.Uuid)
+	presenceChanged(in.Uuid)
+	if err := RemovePlayerFromContext(in.Uuid); err != nil {
+		logger.WarnContext(withPlayer(ctx, in.Uuid), "remove logged out player from rooms failed", "error", err)
+	}
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "player logged out")
+	return &pb.Empty{}, nil
+}
+
+func newSessionToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+func tokenMatches(player *Player, token string) bool {
+	return token != "" && subtle.ConstantTimeCompare([]byte(player.token), []byte(token)) == 1
+}
+
+// validSession -- true when the token belongs to the player's current session
+func validSession(playerID string, token string) bool {
+	lock.RLock()
+	defer lock.RUnlock()
+	player, ok := players[playerID]
+	return ok && tokenMatches(player, token)
+}
+
+// close -- ends the session once, its GameStream returns after flushing queued messages
+func (p *Player) close() {
+	p.closeOnce.Do(func() {
+		close(p.done)
+	})
+}
+
+// kickSession -- tells the client why and closes the session, the caller holds the lock
+func kickSession(player *Player, reason string) {
+	deliver(player, &pb.GameMessage{
+		Action: &pb.GameMessage_SessionEvent{SessionEvent: &pb.SessionEvent{Reason: reason}},
+	})
+	player.close()
+	if err := RemovePlayerFromContext(player.uuid); err != nil {
+		logger.Warn("remove kicked player from rooms failed", "player", player.uuid, "error", err)
+	}
+	logger.Info("session kicked", "player", player.uuid, "reason", reason)
+}
+
+// sessionAlive -- true while the session has a GameStream or was just created, the caller holds the lock
+func sessionAlive(player *Player) bool {
+	return player.streaming || time.Since(player.createdAt) < sessionStreamGrace
+}
+
+// attachStream -- binds a GameStream to the session the token belongs to, one stream per session
+func attachStream(playerID string, token string) (*Player, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	player, ok := players[playerID]
+	if !ok || !tokenMatches(player, token) {
+		return nil, errors.New("no active session for this player")
+	}
+	if player.streaming {
+		return nil, errors.New("a game stream is already open for this session")
+	}
+	player.streaming = true
+	return player, nil
+}
+
+// detachStream -- a session ends with its GameStream, so a client that drops without Logout does not keep it
+func detachStream(player *Player) {
+	lock.Lock()
+	defer lock.Unlock()
+	player.streaming = false
+	if players[player.uuid] != player {
+		// already logged out, kicked or replaced
+		return
+	}
+	player.close()
+	delete(players, player.uuid)
+	presenceChanged(player.uuid)
+	if err := RemovePlayerFromContext(player.uuid); err != nil {
+		logger.Warn("remove disconnected player from rooms failed", "player", player.uuid, "error", err)
+	}
+	logger.Info("session ended with its game stream", "player", player.uuid)
+}
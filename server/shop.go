@@ -0,0 +1,304 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// shopOffer -- what a catalog skin costs right now
+type shopOffer struct {
+	price      int
+	discount   int    // percent
+	rotationID string // set when the skin is a limited item of a running rotation
+	stock      int
+}
+
+func shopFile() string {
+	if path := os.Getenv("SHOP_FILE"); path != "" {
+		return path
+	}
+	return "config/shop.json"
+}
+
+func (s *server) GetShop(_ context.Context, _ *pb.Empty) (*pb.Shop, error) {
+	now := time.Now()
+	rotations, err := activeRotations(now)
+	if err != nil {
+		return nil, err
+	}
+	events, err := activeEvents(now)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.Shop{}
+	for _, rotation := range rotations {
+		for _, item := range rotation.Items {
+			skin, err := catalogSkin(item.SkinType, item.SkinID)
+			if err != nil || !skinAvailable(skin, now) {
+				continue
+			}
+			offer := priceOffer(skin, rotations, events)
+			out := &pb.ShopOffer{
+				Skin:            catalogSkinMessage(skin),
+				Price:           int32(offer.price),
+				DiscountPercent: int32(offer.discount),
+				Stock:           int32(item.Stock),
+				EndsAt:          rotation.EndsAt.Unix(),
+			}
+			if item.Stock > 0 {
+				sold, err := soldStock(rotation.RotationID, item.SkinType, item.SkinID)
+				if err != nil {
+					return nil, err
+				}
+				if sold < item.Stock {
+					out.Remaining = int32(item.Stock - sold)
+				}
+			}
+			result.Featured = append(result.Featured, out)
+		}
+	}
+	for _, event := range events {
+		result.Events = append(result.Events, &pb.ShopEvent{
+			EventID:         event.EventID,
+			Name:            event.Name,
+			DiscountPercent: int32(event.DiscountPercent),
+			SkinTypes:       event.SkinTypes,
+			EndsAt:          event.EndsAt.Unix(),
+		})
+	}
+	return result, nil
+}
+
+func activeRotations(now time.Time) ([]model.ShopRotation, error) {
+	collection, err := db.GetShopRotationCollection()
+	if err != nil {
+		return nil, errors.New("[DB] shop rotation table err")
+	}
+	filter := bson.M{"startsAt": bson.M{"$lte": now}, "endsAt": bson.M{"$gt": now}}
+	cursor, err := collection.Find(context.TODO(), filter, options.Find().SetSort(bson.M{"startsAt": 1}))
+	if err != nil {
+		return nil, errors.New("[DB] err while load shop")
+	}
+	var rotations []model.ShopRotation
+	if err := cursor.All(context.TODO(), &rotations); err != nil {
+		return nil, errors.New("[DB] err while load shop")
+	}
+	return rotations, nil
+}
+
+// limitedSkins -- skins sold with a stock limit in any of the rotations
+func limitedSkins(rotations []model.ShopRotation) map[[2]int32]bool {
+	limited := make(map[[2]int32]bool)
+	for _, rotation := range rotations {
+		for _, item := range rotation.Items {
+			if item.Stock > 0 {
+				limited[[2]int32{item.SkinType, item.SkinID}] = true
+			}
+		}
+	}
+	return limited
+}
+
+func allRotations() ([]model.ShopRotation, error) {
+	collection, err := db.GetShopRotationCollection()
+	if err != nil {
+		return nil, errors.New("[DB] shop rotation table err")
+	}
+	cursor, err := collection.Find(context.TODO(), bson.M{})
+	if err != nil {
+		return nil, errors.New("[DB] err while load shop")
+	}
+	var rotations []model.ShopRotation
+	if err := cursor.All(context.TODO(), &rotations); err != nil {
+		return nil, errors.New("[DB] err while load shop")
+	}
+	return rotations, nil
+}
+
+func activeEvents(now time.Time) ([]model.ShopEvent, error) {
+	collection, err := db.GetShopEventCollection()
+	if err != nil {
+		return nil, errors.New("[DB] shop event table err")
+	}
+	filter := bson.M{"startsAt": bson.M{"$lte": now}, "endsAt": bson.M{"$gt": now}}
+	cursor, err := collection.Find(context.TODO(), filter, options.Find().SetSort(bson.M{"startsAt": 1}))
+	if err != nil {
+		return nil, errors.New("[DB] err while load shop")
+	}
+	var events []model.ShopEvent
+	if err := cursor.All(context.TODO(), &events); err != nil {
+		return nil, errors.New("[DB] err while load shop")
+	}
+	return events, nil
+}
+
+// currentOffer -- the price of a catalog skin with the running rotations and events applied,
+// an error for a limited skin outside of its rotations
+func currentOffer(skin *model.CatalogSkin, now time.Time) (*shopOffer, error) {
+	rotations, err := activeRotations(now)
+	if err != nil {
+		return nil, err
+	}
+	events, err := activeEvents(now)
+	if err != nil {
+		return nil, err
+	}
+	offer := priceOffer(skin, rotations, events)
+	if offer.stock == 0 {
+		all, err := allRotations()
+		if err != nil {
+			return nil, err
+		}
+		if limitedSkins(all)[[2]int32{skin.SkinType, skin.SkinID}] {
+			return nil, errors.New("this skin is only sold during its shop rotation")
+		}
+	}
+	return offer, nil
+}
+
+// priceOffer -- discounts do not add up, the largest one applies
+func priceOffer(skin *model.CatalogSkin, rotations []model.ShopRotation, events []model.ShopEvent) *shopOffer {
+	offer := &shopOffer{}
+	for _, rotation := range rotations {
+		for _, item := range rotation.Items {
+			if item.SkinType != skin.SkinType || item.SkinID != skin.SkinID {
+				continue
+			}
+			if item.DiscountPercent > offer.discount {
+				offer.discount = item.DiscountPercent
+			}
+			if item.Stock > 0 && offer.rotationID == "" {
+				offer.rotationID, offer.stock = rotation.RotationID, item.Stock
+			}
+		}
+	}
+	for _, event := range events {
+		if len(event.SkinTypes) > 0 && !containsType(event.SkinTypes, skin.SkinType) {
+			continue
+		}
+		if event.DiscountPercent > offer.discount {
+			offer.discount = event.DiscountPercent
+		}
+	}
+	if offer.discount > 100 {
+		offer.discount = 100
+	}
+	offer.price = skin.Price * (100 - offer.discount) / 100
+	return offer
+}
+
+func containsType(list []int32, value int32) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func soldStock(rotationID string, skinType int32, skinID int32) (int, error) {
+	collection, err := db.GetShopStockCollection()
+	if err != nil {
+		return 0, errors.New("[DB] shop stock table err")
+	}
+	var stock model.ShopStock
+	err = collection.FindOne(context.TODO(), bson.M{"rotationID": rotationID, "skinType": skinType, "skinID": skinID}).Decode(&stock)
+	if err == mongo.ErrNoDocuments {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.New("[DB] err while load shop stock")
+	}
+	return stock.Sold, nil
+}
+
+// reserveStock -- takes one copy of a limited item, an error when it is sold out
+func reserveStock(offer *shopOffer, skinType int32, skinID int32) error {
+	if offer.stock == 0 {
+		return nil
+	}
+	collection, err := db.GetShopStockCollection()
+	if err != nil {
+		return errors.New("[DB] shop stock table err")
+	}
+	key := bson.M{"rotationID": offer.rotationID, "skinType": skinType, "skinID": skinID}
+	if _, err := collection.UpdateOne(context.TODO(), key, bson.M{"$setOnInsert": bson.M{"sold": 0}}, options.Update().SetUpsert(true)); err != nil {
+		return errors.New("[DB] cannot update shop stock")
+	}
+	filter := bson.M{"rotationID": offer.rotationID, "skinType": skinType, "skinID": skinID, "sold": bson.M{"$lt": offer.stock}}
+	result, err := collection.UpdateOne(context.TODO(), filter, bson.M{"$inc": bson.M{"sold": 1}})
+	if err != nil {
+		return errors.New("[DB] cannot update shop stock")
+	}
+	if result.ModifiedCount == 0 {
+		return errors.New("this item is sold out")
+	}
+	return nil
+}
+
+// releaseStock -- puts back a copy taken by a purchase or gift that did not go through, nothing without a rotation
+func releaseStock(rotationID string, skinType int32, skinID int32) {
+	if rotationID == "" {
+		return
+	}
+	collection, err := db.GetShopStockCollection()
+	if err == nil {
+		filter := bson.M{"rotationID": rotationID, "skinType": skinType, "skinID": skinID}
+		_, err = collection.UpdateOne(context.TODO(), filter, bson.M{"$inc": bson.M{"sold": -1}})
+	}
+	if err != nil {
+		logger.Error("shop stock not released", "rotation", rotationID, "skinType", skinType, "skinID", skinID, "error", err)
+	}
+}
+
+// seedShop -- writes the rotations and events of the shop file into their collections, matched on their IDs
+func seedShop(path string) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		logger.Warn("shop file not loaded", "path", path, "error", err)
+		return
+	}
+	var shop struct {
+		Rotations []model.ShopRotation
+		Events    []model.ShopEvent
+	}
+	if err := json.Unmarshal(raw, &shop); err != nil {
+		logger.Error("shop file is invalid", "path", path, "error", err)
+		return
+	}
+
+	rotationCollection, err := db.GetShopRotationCollection()
+	if err != nil {
+		logger.Warn("shop not seeded", "error", err)
+		return
+	}
+	for _, rotation := range shop.Rotations {
+		filter := bson.M{"rotationID": rotation.RotationID}
+		if _, err := rotationCollection.ReplaceOne(context.TODO(), filter, rotation, options.Replace().SetUpsert(true)); err != nil {
+			logger.Warn("shop rotation not seeded", "rotation", rotation.RotationID, "error", err)
+		}
+	}
+	eventCollection, err := db.GetShopEventCollection()
+	if err != nil {
+		logger.Warn("shop not seeded", "error", err)
+		return
+	}
+	for _, event := range shop.Events {
+		filter := bson.M{"eventID": event.EventID}
+		if _, err := eventCollection.ReplaceOne(context.TODO(), filter, event, options.Replace().SetUpsert(true)); err != nil {
+			logger.Warn("shop event not seeded", "event", event.EventID, "error", err)
+		}
+	}
+	logger.Info("shop seeded", "path", path, "rotations", len(shop.Rotations), "events", len(shop.Events))
+}
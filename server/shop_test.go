@@ -0,0 +1,69 @@
+package main
+
+import (
+	"air-hockey-backend/model"
+	"testing"
+)
+
+func TestPriceOffer(t *testing.T) {
+	golden := &model.CatalogSkin{SkinType: 1, SkinID: 3, Price: 1000}
+	weekly := model.ShopRotation{RotationID: "2026-w43", Items: []model.ShopRotationItem{
+		{SkinType: 1, SkinID: 3, DiscountPercent: 20, Stock: 50},
+		{SkinType: 2, SkinID: 1, DiscountPercent: 10},
+	}}
+	flash := model.ShopRotation{RotationID: "flash", Items: []model.ShopRotationItem{
+		{SkinType: 1, SkinID: 3, DiscountPercent: 30},
+	}}
+	tests := []struct {
+		name      string
+		skin      *model.CatalogSkin
+		rotations []model.ShopRotation
+		events    []model.ShopEvent
+		want      shopOffer
+	}{
+		{"full price", golden, nil, nil, shopOffer{price: 1000}},
+		{"limited rotation item", golden, []model.ShopRotation{weekly}, nil,
+			shopOffer{price: 800, discount: 20, rotationID: "2026-w43", stock: 50}},
+		{"best rotation discount", golden, []model.ShopRotation{weekly, flash}, nil,
+			shopOffer{price: 700, discount: 30, rotationID: "2026-w43", stock: 50}},
+		{"event for every type", golden, []model.ShopRotation{weekly}, []model.ShopEvent{{DiscountPercent: 25}},
+			shopOffer{price: 750, discount: 25, rotationID: "2026-w43", stock: 50}},
+		{"event for other types", golden, nil, []model.ShopEvent{{DiscountPercent: 25, SkinTypes: []int32{2, 3}}},
+			shopOffer{price: 1000}},
+		{"event for its type", golden, nil, []model.ShopEvent{{DiscountPercent: 25, SkinTypes: []int32{1}}},
+			shopOffer{price: 750, discount: 25}},
+		{"discount capped", golden, nil, []model.ShopEvent{{DiscountPercent: 150}},
+			shopOffer{price: 0, discount: 100}},
+		{"price rounds down", &model.CatalogSkin{SkinType: 2, SkinID: 1, Price: 99}, []model.ShopRotation{weekly}, nil,
+			shopOffer{price: 89, discount: 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := priceOffer(tt.skin, tt.rotations, tt.events); *got != tt.want {
+				t.Errorf("priceOffer() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimitedSkins(t *testing.T) {
+	rotations := []model.ShopRotation{
+		{RotationID: "2026-w43", Items: []model.ShopRotationItem{{SkinType: 1, SkinID: 3, Stock: 50}, {SkinType: 2, SkinID: 1}}},
+		{RotationID: "2026-w44", Items: []model.ShopRotationItem{{SkinType: 3, SkinID: 3, Stock: 30}}},
+	}
+	limited := limitedSkins(rotations)
+	tests := []struct {
+		skinType, skinID int32
+		want             bool
+	}{
+		{1, 3, true},
+		{3, 3, true},
+		{2, 1, false},
+		{1, 1, false},
+	}
+	for _, tt := range tests {
+		if got := limited[[2]int32{tt.skinType, tt.skinID}]; got != tt.want {
+			t.Errorf("limited %d/%d = %v, want %v", tt.skinType, tt.skinID, got, tt.want)
+		}
+	}
+}
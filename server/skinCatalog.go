@@ -0,0 +1,179 @@
+package main
+
+import (
+	"air-hockey-backend/config/db"
+	"air-hockey-backend/model"
+	"air-hockey-backend/pb"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func skinCatalogFile() string {
+	if path := os.Getenv("SKIN_CATALOG_FILE"); path != "" {
+		return path
+	}
+	return "config/skinCatalog.json"
+}
+
+func (s *server) GetSkinCatalog(_ context.Context, _ *pb.Empty) (*pb.SkinCatalog, error) {
+	collection, err := db.GetSkinCatalogCollection()
+	if err != nil {
+		return nil, errors.New("[DB] skin catalog table err")
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "skinType", Value: 1}, {Key: "skinID", Value: 1}})
+	cursor, err := collection.Find(context.TODO(), bson.M{}, opt)
+	if err != nil {
+		return nil, errors.New("[DB] err while load skin catalog")
+	}
+	var skins []model.CatalogSkin
+	if err := cursor.All(context.TODO(), &skins); err != nil {
+		return nil, errors.New("[DB] err while load skin catalog")
+	}
+
+	now := time.Now()
+	all, err := allRotations()
+	if err != nil {
+		return nil, err
+	}
+	active, err := activeRotations(now)
+	if err != nil {
+		return nil, err
+	}
+	// limited skins are only listed while one of their rotations runs
+	limited, running := limitedSkins(all), limitedSkins(active)
+
+	result := &pb.SkinCatalog{}
+	for _, skin := range skins {
+		key := [2]int32{skin.SkinType, skin.SkinID}
+		if skinAvailable(&skin, now) && (!limited[key] || running[key]) {
+			result.Skins = append(result.Skins, catalogSkinMessage(&skin))
+		}
+	}
+	return result, nil
+}
+
+func (s *server) PurchaseSkin(ctx context.Context, in *pb.PurchaseSkinReq) (*pb.PurchaseResult, error) {
+	if !validSession(in.Uuid, in.SessionToken) {
+		return nil, errors.New("no active session for this player")
+	}
+	skin, err := catalogSkin(in.SkinType, in.SkinID)
+	if err != nil {
+		return nil, err
+	}
+	if !skinAvailable(skin, time.Now()) {
+		return nil, errors.New("this skin is not available")
+	}
+	owned, err := ownsItem(in.Uuid, in.SkinType, in.SkinID)
+	if err != nil {
+		return nil, err
+	}
+	if owned {
+		return nil, errors.New("skin already owned")
+	}
+
+	offer, err := currentOffer(skin, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := reserveStock(offer, in.SkinType, in.SkinID); err != nil {
+		return nil, err
+	}
+	reference := skinReference(in.SkinType, in.SkinID)
+	cash, err := applyCashChange(in.Uuid, -offer.price, cashPurchase, reference)
+	if err != nil {
+		releaseStock(offer.rotationID, in.SkinType, in.SkinID)
+		return nil, err
+	}
+	// MongoDB has no transaction here, a failed grant gives the cash and the stock back
+	added, err := grantItem(in.Uuid, in.SkinType, in.SkinID, 1)
+	if err != nil || !added {
+		releaseStock(offer.rotationID, in.SkinType, in.SkinID)
+		if _, refundErr := applyCashChange(in.Uuid, offer.price, cashRefund, reference); refundErr != nil {
+			logger.ErrorContext(withPlayer(ctx, in.Uuid), "refund of failed skin purchase failed", "skinType", in.SkinType, "skinID", in.SkinID, "price", offer.price, "error", refundErr)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New("skin already owned")
+	}
+	skins, err := GetSkinByID(in.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	logger.InfoContext(withPlayer(ctx, in.Uuid), "skin purchased", "skinType", in.SkinType, "skinID", in.SkinID, "price", offer.price, "discount", offer.discount, "cash", cash)
+	return &pb.PurchaseResult{Cash: int32(cash), Skins: skins}, nil
+}
+
+// catalogSkin -- the catalog entry of a skin, an error when it is not in the catalog
+func catalogSkin(skinType int32, skinID int32) (*model.CatalogSkin, error) {
+	collection, err := db.GetSkinCatalogCollection()
+	if err != nil {
+		return nil, errors.New("[DB] skin catalog table err")
+	}
+	var skin model.CatalogSkin
+	err = collection.FindOne(context.TODO(), bson.M{"skinType": skinType, "skinID": skinID}).Decode(&skin)
+	if err == mongo.ErrNoDocuments {
+		return nil, errors.New("unknown skin")
+	}
+	if err != nil {
+		return nil, errors.New("[DB] err while load skin catalog")
+	}
+	return &skin, nil
+}
+
+func skinAvailable(skin *model.CatalogSkin, now time.Time) bool {
+	if !skin.AvailableFrom.IsZero() && now.Before(skin.AvailableFrom) {
+		return false
+	}
+	return skin.AvailableUntil.IsZero() || now.Before(skin.AvailableUntil)
+}
+
+func catalogSkinMessage(skin *model.CatalogSkin) *pb.CatalogSkin {
+	out := &pb.CatalogSkin{
+		SkinType: skin.SkinType,
+		SkinID:   skin.SkinID,
+		Name:     skin.Name,
+		Price:    int32(skin.Price),
+		Rarity:   skin.Rarity,
+	}
+	if !skin.AvailableFrom.IsZero() {
+		out.AvailableFrom = skin.AvailableFrom.Unix()
+	}
+	if !skin.AvailableUntil.IsZero() {
+		out.AvailableUntil = skin.AvailableUntil.Unix()
+	}
+	return out
+}
+
+// seedSkinCatalog -- writes the catalog file into the skinCatalog collection, entries are matched on SkinType and SkinID
+func seedSkinCatalog(path string) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		logger.Warn("skin catalog file not loaded", "path", path, "error", err)
+		return
+	}
+	var skins []model.CatalogSkin
+	if err := json.Unmarshal(raw, &skins); err != nil {
+		logger.Error("skin catalog file is invalid", "path", path, "error", err)
+		return
+	}
+	collection, err := db.GetSkinCatalogCollection()
+	if err != nil {
+		logger.Warn("skin catalog not seeded", "error", err)
+		return
+	}
+	for _, skin := range skins {
+		filter := bson.M{"skinType": skin.SkinType, "skinID": skin.SkinID}
+		if _, err := collection.ReplaceOne(context.TODO(), filter, skin, options.Replace().SetUpsert(true)); err != nil {
+			logger.Warn("skin catalog entry not seeded", "skinType", skin.SkinType, "skinID", skin.SkinID, "error", err)
+		}
+	}
+	logger.Info("skin catalog seeded", "path", path, "skins", len(skins))
+}
@@ -9,26 +9,59 @@ import (
 	"github.com/google/uuid"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"golang.org/x/crypto/bcrypt"
-	"log"
 )
 
 
-func RegisterHandler(inName string, inUserName string, inPassword string) error{
+const startingCash = 1000			// default cash for new player
+
+func RegisterHandler(inName string, inUserName string, inPassword string, inEmail string) error{
+	if err := validateUserName(inUserName); err != nil {
+		return err
+	}
+	if err := passwordPolicy.Validate(inPassword); err != nil {
+		return err
+	}
+	inName, err := validateDisplayName(inName)
+	if err != nil {
+		return err
+	}
+	inEmail, err = normalizeEmail(inEmail)
+	if err != nil {
+		return err
+	}
 	user := &model.User{
 		PlayerID: uuid.New().String(),
 		Name:     inName,
 		UserName: inUserName,
 		Password: inPassword,
-		Cash: 1000,
+		Email:    inEmail,
+		Cash: startingCash,
 	}
-	// default cash for new player
 
 	collection, err := db.GetUserCollection()
 	if err != nil{
 		return errors.New("")
 	}
+	taken, err := displayNameTaken(collection, inName, user.PlayerID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return errors.New("display name already taken")
+	}
+	emailInUse := ""
+	if inEmail != "" {
+		taken, err := emailTaken(collection, inEmail, user.PlayerID)
+		if err != nil {
+			return err
+		}
+		if taken {
+			emailInUse, user.Email = inEmail, ""
+		}
+	}
 	// find if there is a user which have the same information -> if not then insert
 	var result model.User
 
@@ -39,18 +72,21 @@ func RegisterHandler(inName string, inUserName string, inPassword string) error{
 
 	if err != nil{
 		if err.Error() == "mongo: no documents in result"{
-			hash, err:= bcrypt.GenerateFromPassword([]byte(inPassword), 5)
+			hash, err:= hashPassword(inPassword)
 			// and then check if hashing password has some problem
 			if err != nil{
 				return errors.New("hashing err")
 			}
-			user.Password = string(hash)
+			user.Password = hash
 
 			_, err = collection.InsertOne(context.TODO(), &user)
 			if err != nil{
 				user = nil			// free memory
 				return errors.New("create user err")
 			}
+			if emailInUse != ""{
+				go notifyEmailInUse(emailInUse)
+			}
 			return nil
 		}
 		return err
@@ -60,36 +96,69 @@ func RegisterHandler(inName string, inUserName string, inPassword string) error{
 }
 
 func LoginHandler(inUserName string, inPassword string) (*model.User, error) {
+	if loginAttempts.locked(inUserName) {
+		return nil, errors.New("too many failed login attempts, try again later")
+	}
 	// get data from collection users
 	collection, err := db.GetUserCollection()
 
 	if err != nil {
-		log.Println(err)
+		logger.Error("load user collection failed", "error", err)
 		return nil, errors.New("load db user err")
 	}
 	var result model.User
 
 	err = collection.FindOne(context.TODO(), bson.D{primitive.E{Key: "userName", Value: inUserName}}).Decode(&result)
 	if err != nil {
+		loginAttempts.fail(inUserName)
 		return nil, errors.New("invalid username")
 	}
 	//	check password
 	err = bcrypt.CompareHashAndPassword([]byte(result.Password), []byte(inPassword))
 	if err != nil {
+		loginAttempts.fail(inUserName)
 		return nil, errors.New("invalid password")
 	}
 
 	// successfully login
+	loginAttempts.succeed(inUserName)
+	if needsRehash(result.Password) {
+		rehashPassword(collection, &result, inPassword)
+	}
 	return &result, nil
 }
 
-func storeRecord( inRecord *pb.Record) (string, error){
+// rehashPassword -- upgrade a hash made with an older cost, the login still succeeds if this fails
+func rehashPassword(collection *mongo.Collection, user *model.User, password string) {
+	hash, err := hashPassword(password)
+	if err != nil {
+		logger.Warn("rehash password failed", "player", user.PlayerID, "error", err)
+		return
+	}
+	// only replace the hash we verified, in case the password changed meanwhile
+	filter := bson.M{"playerID": user.PlayerID, "password": user.Password}
+	_, err = collection.UpdateOne(context.TODO(), filter, bson.M{"$set": bson.M{"password": hash}})
+	if err != nil {
+		logger.Warn("store rehashed password failed", "player", user.PlayerID, "error", err)
+		return
+	}
+	user.Password = hash
+	logger.Info("upgraded password hash", "player", user.PlayerID)
+}
+
+// storeRecord -- stores the match as the server tracked it, only the record time comes from the client
+func storeRecord(recordTime string, match *roomMatch) (string, error){
 	recordID := uuid.New()
 	record := &model.Record{
 		RecordID:   recordID.String(),
-		RecordTime: inRecord.GetRecordTime(),
-		Team1:      inRecord.GetTeam1(),
-		Team2:      inRecord.GetTeam2(),
+		RecordTime: recordTime,
+		MatchScore: [2]int{int(match.scoreTeam1), int(match.scoreTeam2)},
+	}
+	for _, playerID := range match.team1{
+		record.Team1 = append(record.Team1, match.userNames[playerID])
+	}
+	for _, playerID := range match.team2{
+		record.Team2 = append(record.Team2, match.userNames[playerID])
 	}
 
 	collection, err := db.GetRecordCollection()
@@ -102,7 +171,7 @@ func storeRecord( inRecord *pb.Record) (string, error){
 	if err != nil{
 		return "nil",errors.New("create user err")
 	}
-	record = nil
+	go finalizeMatch(record.RecordID, match)
 	return recordID.String(), nil
 }
 
@@ -162,187 +231,56 @@ func updateHighScore( playerRank *model.PlayerRank) error{
 
 	// decode the result
 	doc := bson.M{}
-	decodeErr := updateResult.Decode(&doc)
-	log.Print(decodeErr)
+	if decodeErr := updateResult.Decode(&doc); decodeErr != nil {
+		logger.Warn("decode high score update failed", "error", decodeErr)
+	}
 
 	return nil
 }
 
+// changeRankAndCash -- sets the rank, the cash sent by the client is ignored since finalizeMatch pays match rewards
 func changeRankAndCash(rankAndCast *pb.RankAndCash) error {
 	playerID := rankAndCast.PlayerID
-	playerCash := rankAndCast.Cash
 	playerRank := rankAndCast.Rank
 
 	collection, err := db.GetUserCollection()
 	if err != nil{
-		return errors.New("[DB] high score table err")
-	}
-
-	var resultPlayer model.User
-	err = collection.FindOne(context.TODO(), bson.D{primitive.E{Key: "playerID", Value: playerID }}).Decode(&resultPlayer)
-	if err != nil{
-		if err.Error() == "mongo: no documents in result"{
-				return errors.New("no record of user in DB")
-		} else {
-			return errors.New("cannot find record of user, undetected err")
-		}
+		return errors.New("[DB] user table err")
 	}
 
-	// update record if no err
 	filter := bson.M{"playerID": playerID}
 	update := bson.M{
-		"$set": bson.M{"cash": playerCash, "rank": playerRank},
-	}
-
-	upsert := true
-	after := options.After
-	opt := options.FindOneAndUpdateOptions{
-		ReturnDocument: &after,
-		Upsert:         &upsert,
+		"$set": bson.M{"rank": playerRank},
 	}
-
-	updateResult :=  collection.FindOneAndUpdate(context.TODO(), filter, update, &opt)
-	if updateResult.Err() != nil {
-		return updateResult.Err()
-	}
-
-	// decode the result
-	doc := bson.M{}
-	decodeErr := updateResult.Decode(&doc)
-	log.Print(decodeErr)
-
-	return nil
-}
-
-func playerUpdateSkin(addSkinReq *pb.AddNewSkin) error{
-	playerID := addSkinReq.PlayerID
-	skinID := addSkinReq.SkinID
-	skinType := addSkinReq.SkinType
-
-
-
-	collection, err := db.GetSkinCollection()
+	updateResult, err := collection.UpdateOne(context.TODO(), filter, update)
 	if err != nil{
-		return errors.New("[DB] skin table err")
+		return errors.New("[DB] cannot update rank")
 	}
-
-	var resultPlayerSkin model.Skin
-	err = collection.FindOne(context.TODO(), bson.D{primitive.E{Key: "playerID", Value: playerID }}).Decode(&resultPlayerSkin)
-	if err != nil{
-		// case there is no record in the database
-		if err.Error() == "mongo: no documents in result"{
-			var containerArr []int32
-			var emptyArr	 []int32
-			switch skinType {
-			case 1:
-				puck := skinID
-				containerArr = append(containerArr, puck)
-				newSkin := &model.Skin{
-					PlayerID: playerID,
-					PuckSkin: containerArr,
-					StrikerSkin: emptyArr,
-					TableSkin: emptyArr,
-				}
-				_, err = collection.InsertOne(context.TODO(), &newSkin)
-				if err != nil{
-					return errors.New("[DB] cannot insert new skin record")
-				}
-			case 2:
-				striker := skinID
-				containerArr = append(containerArr, striker)
-				newSkin := &model.Skin{
-					PlayerID: playerID,
-					PuckSkin: emptyArr,
-					StrikerSkin: containerArr,
-					TableSkin: emptyArr,
-				}
-				_, err = collection.InsertOne(context.TODO(), &newSkin)
-				if err != nil{
-					return errors.New("[DB] cannot insert new skin record")
-				}
-			case 3:
-				table := skinID
-				containerArr = append(containerArr, table)
-				newSkin := &model.Skin{
-					PlayerID: playerID,
-					PuckSkin: emptyArr,
-					StrikerSkin: emptyArr,
-					TableSkin: containerArr,
-				}
-				_, err = collection.InsertOne(context.TODO(), &newSkin)
-				if err != nil{
-					return errors.New("[DB] cannot insert new skin record")
-				}
-			}
-			return nil
-		} else {
-			return errors.New("cannot find record of player skin, undetected err")
-		}
+	if updateResult.MatchedCount == 0{
+		return errors.New("no record of user in DB")
 	}
-
-
-	// update record if no err - switch case to check which array of skin need to be updated
-	filter := bson.M{"playerID": playerID}
-	//update := bson.M{
-	//	"$set": bson.M{"cash": playerCash, "rank": playerRank},
-	//}
-	upsert := true
-	after := options.After
-	opt := options.FindOneAndUpdateOptions{
-		ReturnDocument: &after,
-		Upsert:         &upsert,
-	}
-
-	switch skinType {
-	case 1:
-		updateVar := append(resultPlayerSkin.PuckSkin, skinID)
-		update := bson.M{
-			"$set": bson.M{"puckSkin": updateVar},
-		}
-		updateResult :=  collection.FindOneAndUpdate(context.TODO(), filter, update, &opt)
-		if updateResult.Err() != nil {
-			return updateResult.Err()
-		}
-	case 2:
-		updateVar := append(resultPlayerSkin.StrikerSkin, skinID)
-		update := bson.M{
-			"$set": bson.M{"strikerSkin": updateVar},
-		}
-		updateResult :=  collection.FindOneAndUpdate(context.TODO(), filter, update, &opt)
-		if updateResult.Err() != nil {
-			return updateResult.Err()
-		}
-	case 3:
-		updateVar := append(resultPlayerSkin.TableSkin, skinID)
-		update := bson.M{
-			"$set": bson.M{"tableSkin": updateVar},
-		}
-		updateResult :=  collection.FindOneAndUpdate(context.TODO(), filter, update, &opt)
-		if updateResult.Err() != nil {
-			return updateResult.Err()
-		}
-
-	}
-
 	return nil
 }
 
-func  GetSkinByID(playerID int) (*pb.SkinList, error) {
-	collection, err := db.GetSkinCollection()
+// GetSkinByID -- the player's skins of the four original types, read from the inventory
+func  GetSkinByID(playerID string) (*pb.SkinList, error) {
+	items, err := inventoryItems(playerID, 0)
 	if err != nil{
-		return nil, errors.New("[DB] skin table err")
+		return nil, err
 	}
 
-	var resultPlayerSkin model.Skin
-	err = collection.FindOne(context.TODO(), bson.D{primitive.E{Key: "playerID", Value: playerID }}).Decode(&resultPlayerSkin)
-	if err != nil{
-		return nil, errors.New("[DB] err while load skin")
-	}
-
-	returnSkins := &pb.SkinList{
-		PuckIDList: resultPlayerSkin.PuckSkin,
-		StrikerIDList: resultPlayerSkin.StrikerSkin,
-		TableIDList: resultPlayerSkin.TableSkin,
+	returnSkins := &pb.SkinList{}
+	for _, item := range items{
+		switch item.ItemType {
+		case itemPuck:
+			returnSkins.PuckIDList = append(returnSkins.PuckIDList, item.ItemID)
+		case itemStriker:
+			returnSkins.StrikerIDList = append(returnSkins.StrikerIDList, item.ItemID)
+		case itemTable:
+			returnSkins.TableIDList = append(returnSkins.TableIDList, item.ItemID)
+		case itemEmote:
+			returnSkins.EmoteIDList = append(returnSkins.EmoteIDList, item.ItemID)
+		}
 	}
 
 	return returnSkins, nil
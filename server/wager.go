@@ -0,0 +1,206 @@
+package main
+
+import (
+	"air-hockey-backend/pb"
+	"errors"
+	"os"
+	"strconv"
+)
+
+const (
+	cashWagerStake  = "wager_stake"
+	cashWagerPayout = "wager_payout"
+)
+
+// wagerHouseCut -- percent of the pot kept by the house, WAGER_HOUSE_CUT overrides it
+var wagerHouseCut = 5
+
+func init() {
+	if value := os.Getenv("WAGER_HOUSE_CUT"); value != "" {
+		cut, err := strconv.Atoi(value)
+		if err != nil || cut < 0 || cut > 100 {
+			logger.Warn("invalid WAGER_HOUSE_CUT, keeping the default", "value", value, "default", wagerHouseCut)
+			return
+		}
+		wagerHouseCut = cut
+	}
+}
+
+// wager -- the stakes escrowed for one match, teams are the ones of the room's match
+type wager struct {
+	stake    int
+	team1    []string
+	team2    []string
+	escrowed bool // every stake was taken, set under the lock
+	settled  bool // set under the lock by whoever pays out or refunds
+}
+
+func (w *wager) players() []string {
+	return append(append([]string{}, w.team1...), w.team2...)
+}
+
+// wagerTransition -- escrows the stakes when a wager room starts and pays the pot when it ends,
+// false when the game state must not be broadcast
+func wagerTransition(msg *pb.GameMessage) bool {
+	state := msg.GetGameState()
+	lock.Lock()
+	room, ok := rooms[state.RoomID]
+	if !ok || room.stake == 0 {
+		lock.Unlock()
+		return true
+	}
+
+	if state.IsPlaying == 1 && room.wager != nil && !room.wager.escrowed {
+		// a start is already being escrowed
+		lock.Unlock()
+		return false
+	}
+	if state.IsPlaying == 1 && room.state != roomPlaying && room.wager == nil {
+		if len(room.roomPlayers) < 2 {
+			refuseStart(room, msg.Sender, "a wager match needs at least two players")
+			lock.Unlock()
+			return false
+		}
+		w := &wager{stake: int(room.stake), team1: room.match.team1, team2: room.match.team2}
+		for _, id := range w.players() {
+			// only players holding a live session put their cash in
+			if _, online := players[id]; !online {
+				refuseStart(room, msg.Sender, "every player must be online for a wager match")
+				lock.Unlock()
+				return false
+			}
+		}
+		room.wager = w // claimed before the DB calls so a repeated start does not escrow twice
+		lock.Unlock()
+		return escrowWager(state.RoomID, w, msg.Sender)
+	}
+
+	if state.IsPlaying != 1 && room.state == roomPlaying && room.wager != nil && room.wager.escrowed {
+		w := room.wager
+		room.wager = nil
+		w.settled = true
+		maxScore := room.maxScore
+		scoreTeam1, scoreTeam2 := room.match.scoreTeam1, room.match.scoreTeam2
+		lock.Unlock()
+		go settleWager(state.RoomID, w, scoreTeam1, scoreTeam2, maxScore)
+		return true
+	}
+	lock.Unlock()
+	return true
+}
+
+// escrowWager -- takes the stake of every player, a player short of cash cancels the start and refunds the others
+func escrowWager(roomID string, w *wager, sender string) bool {
+	reference := "room " + roomID
+	var paid []string
+	for _, id := range w.players() {
+		err := refuseGuestStake(id)
+		if err == nil {
+			_, err = applyCashChange(id, -w.stake, cashWagerStake, reference)
+		}
+		if err != nil {
+			logger.Info("wager stake not escrowed", "room", roomID, "player", id, "error", err)
+			refundStakes(roomID, paid, w.stake)
+			lock.Lock()
+			if room, ok := rooms[roomID]; ok && room.wager == w {
+				room.wager = nil
+				refuseStart(room, sender, "a player cannot put in the stake")
+			}
+			lock.Unlock()
+			return false
+		}
+		paid = append(paid, id)
+	}
+
+	lock.Lock()
+	room, ok := rooms[roomID]
+	stillOpen := ok && room.wager == w
+	w.escrowed = stillOpen
+	lock.Unlock()
+	if !stillOpen {
+		// the room closed while the stakes were taken
+		refundStakes(roomID, paid, w.stake)
+		return false
+	}
+	logger.Info("wager escrowed", "room", roomID, "stake", w.stake, "players", len(paid))
+	return true
+}
+
+// refuseGuestStake -- guests start with cash, many of them must not feed one account through wagers
+func refuseGuestStake(playerID string) error {
+	guest, err := isGuest(playerID)
+	if err != nil {
+		return err
+	}
+	if guest {
+		return errors.New("guests cannot play for a stake, claim the account first")
+	}
+	return nil
+}
+
+// refuseStart -- tells the room why the match did not start, the caller holds the lock
+func refuseStart(room *Room, sender string, notice string) {
+	out := &pb.GameMessage{
+		Action: &pb.GameMessage_GameState{GameState: &pb.GameState{IsPlaying: 0, RoomID: room.ID.String(), Notice: notice}},
+		Sender: sender,
+	}
+	for _, id := range room.roomPlayers {
+		deliver(players[id], out)
+	}
+}
+
+// settleWager -- pays the pot minus the house cut to the team that reached the target score in the score
+// tracked by the server, a draw or an unfinished match refunds everyone
+func settleWager(roomID string, w *wager, scoreTeam1 int32, scoreTeam2 int32, maxScore int32) {
+	winners := wagerWinners(w, scoreTeam1, scoreTeam2, maxScore)
+	if len(winners) == 0 {
+		logger.Info("wager match ended without a winner, refunding", "room", roomID, "scoreTeam1", scoreTeam1, "scoreTeam2", scoreTeam2)
+		refundStakes(roomID, w.players(), w.stake)
+		return
+	}
+
+	pot, share := wagerPayout(w.stake, len(w.team1)+len(w.team2), len(winners))
+	for _, id := range winners {
+		if _, err := applyCashChange(id, share, cashWagerPayout, "room "+roomID); err != nil {
+			logger.Error("wager payout failed", "room", roomID, "player", id, "amount", share, "error", err)
+		}
+	}
+	logger.Info("wager paid out", "room", roomID, "pot", pot, "share", share, "winners", len(winners))
+}
+
+// wagerWinners -- the team that reached the target score ahead of the other, none for a draw or an unfinished match
+func wagerWinners(w *wager, scoreTeam1 int32, scoreTeam2 int32, maxScore int32) []string {
+	switch {
+	case scoreTeam1 >= maxScore && scoreTeam1 > scoreTeam2:
+		return w.team1
+	case scoreTeam2 >= maxScore && scoreTeam2 > scoreTeam1:
+		return w.team2
+	}
+	return nil
+}
+
+// wagerPayout -- the pot of every stake and what each winner gets of it after the house cut
+func wagerPayout(stake int, players int, winners int) (pot int, share int) {
+	pot = stake * players
+	return pot, (pot - pot*wagerHouseCut/100) / winners
+}
+
+// refundWager -- gives every stake of an aborted match back once, only for escrowed wagers
+func refundWager(roomID string, w *wager) {
+	lock.Lock()
+	if w.settled {
+		lock.Unlock()
+		return
+	}
+	w.settled = true
+	lock.Unlock()
+	refundStakes(roomID, w.players(), w.stake)
+}
+
+func refundStakes(roomID string, playerIDs []string, stake int) {
+	for _, id := range playerIDs {
+		if _, err := applyCashChange(id, stake, cashRefund, "room "+roomID); err != nil {
+			logger.Error("wager refund failed", "room", roomID, "player", id, "amount", stake, "error", err)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWagerWinners(t *testing.T) {
+	w := &wager{stake: 100, team1: []string{"a", "c"}, team2: []string{"b", "d"}}
+	tests := []struct {
+		name           string
+		score1, score2 int32
+		maxScore       int32
+		want           []string
+	}{
+		{"team 1 wins", 7, 3, 7, w.team1},
+		{"team 2 wins", 5, 7, 7, w.team2},
+		{"draw", 7, 7, 7, nil},
+		{"unfinished", 6, 3, 7, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wagerWinners(w, tt.score1, tt.score2, tt.maxScore); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("wagerWinners() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWagerPayout(t *testing.T) {
+	defer func(cut int) { wagerHouseCut = cut }(wagerHouseCut)
+	tests := []struct {
+		name      string
+		houseCut  int
+		stake     int
+		players   int
+		winners   int
+		wantPot   int
+		wantShare int
+	}{
+		{"1v1", 5, 100, 2, 1, 200, 190},
+		{"2v2", 5, 100, 4, 2, 400, 190},
+		{"no house cut", 0, 50, 2, 1, 100, 100},
+		{"cut rounds down", 5, 10, 2, 1, 20, 19},
+		{"share rounds down", 10, 13, 4, 2, 52, 23},
+		{"house takes all", 100, 100, 2, 1, 200, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wagerHouseCut = tt.houseCut
+			pot, share := wagerPayout(tt.stake, tt.players, tt.winners)
+			if pot != tt.wantPot || share != tt.wantShare {
+				t.Errorf("wagerPayout() = %d, %d, want %d, %d", pot, share, tt.wantPot, tt.wantShare)
+			}
+		})
+	}
+}